@@ -0,0 +1,296 @@
+package duckdb
+
+/*
+#include <duckdb.h>
+
+idx_t aggregate_udf_state_size(duckdb_function_info);
+void aggregate_udf_init(duckdb_function_info, duckdb_aggregate_state);
+void aggregate_udf_update(duckdb_function_info, duckdb_data_chunk, duckdb_aggregate_state *);
+void aggregate_udf_combine(duckdb_function_info, duckdb_aggregate_state *, duckdb_aggregate_state *, idx_t);
+void aggregate_udf_finalize(duckdb_function_info, duckdb_aggregate_state *, duckdb_vector, idx_t, idx_t);
+void aggregate_udf_destroy(duckdb_aggregate_state *, idx_t);
+void udf_delete_callback(void *);
+
+typedef idx_t (*aggregate_udf_state_size_t)(duckdb_function_info);
+typedef void (*aggregate_udf_init_t)(duckdb_function_info, duckdb_aggregate_state);
+typedef void (*aggregate_udf_update_t)(duckdb_function_info, duckdb_data_chunk, duckdb_aggregate_state *);
+typedef void (*aggregate_udf_combine_t)(duckdb_function_info, duckdb_aggregate_state *, duckdb_aggregate_state *, idx_t);
+typedef void (*aggregate_udf_finalize_t)(duckdb_function_info, duckdb_aggregate_state *, duckdb_vector, idx_t, idx_t);
+typedef void (*aggregate_udf_destroy_t)(duckdb_aggregate_state *, idx_t);
+*/
+import "C"
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"runtime"
+	"runtime/cgo"
+	"unsafe"
+)
+
+// AggregateFuncConfig contains the fields to configure a user-defined aggregate function.
+type AggregateFuncConfig struct {
+	// InputTypeInfos contains Type information for each input parameter of the aggregate function.
+	InputTypeInfos []TypeInfo
+	// ResultTypeInfo holds the Type information of the aggregate function's result type.
+	ResultTypeInfo TypeInfo
+
+	// SpecialNullHandling passes rows with NULL values to Update, if true.
+	// By default, the aggregate function skips rows, in which any input parameter is NULL.
+	SpecialNullHandling bool
+}
+
+// AggregateFuncExecutor contains the callback functions to execute a user-defined aggregate function.
+// The functions operate on states, which hold the intermediate results of a group.
+//
+// DuckDB aggregates in parallel: each thread updates its own states, and DuckDB then combines the states of
+// the same group. Thus, the functions must be safe for concurrent use on different states.
+// The states never leave the process, so they need no serialization, but a state must not share
+// mutable data with other states, e.g., a slice, as DuckDB can combine and update them concurrently.
+type AggregateFuncExecutor struct {
+	// Init returns a new state, i.e., the intermediate result of an empty group.
+	Init func() any
+	// Update adds a row of values to the state, and returns the updated state.
+	Update func(state any, values []driver.Value) (any, error)
+	// Combine merges the state source into the state target, and returns the merged state.
+	// DuckDB does not use source after combining it.
+	Combine func(target any, source any) (any, error)
+	// Finalize returns the result of a state.
+	Finalize func(state any) (any, error)
+}
+
+// AggregateFunc is the user-defined aggregate function interface.
+// Any aggregate function must implement a Config function, and an Executor function.
+type AggregateFunc interface {
+	// Config returns AggregateFuncConfig to configure the aggregate function.
+	Config() AggregateFuncConfig
+	// Executor returns AggregateFuncExecutor to execute the aggregate function.
+	Executor() AggregateFuncExecutor
+}
+
+// aggregateState boxes the state of a group, so that DuckDB's state memory can hold a handle to it.
+type aggregateState struct {
+	value any
+}
+
+// RegisterAggregateUDF registers a user-defined aggregate function.
+// *sql.Conn is the SQL connection on which to register the aggregate function.
+// name is the function name, and f is the aggregate function's interface AggregateFunc.
+// RegisterAggregateUDF takes ownership of f, so you must pass it as a pointer.
+func RegisterAggregateUDF(c *sql.Conn, name string, f AggregateFunc) error {
+	function, err := createAggregateFunc(name, f)
+	if err != nil {
+		return getError(errAPI, err)
+	}
+
+	// Register the function on the underlying driver connection exposed by c.Raw.
+	err = c.Raw(func(driverConn any) error {
+		con := driverConn.(*conn)
+		state := C.duckdb_register_aggregate_function(con.duckdbCon, function)
+		C.duckdb_destroy_aggregate_function(&function)
+		if state == C.DuckDBError {
+			return getError(errAPI, errAggregateUDFCreate)
+		}
+		return nil
+	})
+	return err
+}
+
+//export aggregate_udf_state_size
+func aggregate_udf_state_size(C.duckdb_function_info) C.idx_t {
+	// The state memory holds a handle to an aggregateState.
+	var h cgo.Handle
+	return C.idx_t(unsafe.Sizeof(h))
+}
+
+//export aggregate_udf_init
+func aggregate_udf_init(function_info C.duckdb_function_info, state C.duckdb_aggregate_state) {
+	extraInfo := C.duckdb_aggregate_function_get_extra_info(function_info)
+	function := getPinned[AggregateFunc](extraInfo)
+
+	*stateHandle(state) = cgo.NewHandle(&aggregateState{value: function.Executor().Init()})
+}
+
+//export aggregate_udf_update
+func aggregate_udf_update(function_info C.duckdb_function_info, input C.duckdb_data_chunk, states *C.duckdb_aggregate_state) {
+	extraInfo := C.duckdb_aggregate_function_get_extra_info(function_info)
+	function := getPinned[AggregateFunc](extraInfo)
+
+	// Initialize the input chunk.
+	var inputChunk DataChunk
+	if err := inputChunk.initFromDuckDataChunk(input, false); err != nil {
+		setAggregateFuncError(function_info, getError(errAPI, err).Error())
+		return
+	}
+
+	update := function.Executor().Update
+	skipNulls := !function.Config().SpecialNullHandling
+	values := make([]driver.Value, len(inputChunk.columns))
+	rowCount := inputChunk.GetSize()
+	stateSlice := unsafe.Slice(states, rowCount)
+
+	// Update the state of each row.
+	var err error
+	for rowIdx := 0; rowIdx < rowCount; rowIdx++ {
+		nullRow := false
+		for colIdx := range values {
+			if values[colIdx], err = inputChunk.GetValue(colIdx, rowIdx); err != nil {
+				setAggregateFuncError(function_info, getError(errAPI, err).Error())
+				return
+			}
+			nullRow = nullRow || values[colIdx] == nil
+		}
+		if skipNulls && nullRow {
+			continue
+		}
+
+		s := getAggregateState(stateSlice[rowIdx])
+		if s.value, err = update(s.value, values); err != nil {
+			setAggregateFuncError(function_info, getError(errAPI, err).Error())
+			return
+		}
+	}
+}
+
+//export aggregate_udf_combine
+func aggregate_udf_combine(function_info C.duckdb_function_info, source *C.duckdb_aggregate_state, target *C.duckdb_aggregate_state, count C.idx_t) {
+	extraInfo := C.duckdb_aggregate_function_get_extra_info(function_info)
+	function := getPinned[AggregateFunc](extraInfo)
+
+	combine := function.Executor().Combine
+	sourceSlice := unsafe.Slice(source, count)
+	targetSlice := unsafe.Slice(target, count)
+
+	var err error
+	for i := range sourceSlice {
+		s := getAggregateState(sourceSlice[i])
+		t := getAggregateState(targetSlice[i])
+		if t.value, err = combine(t.value, s.value); err != nil {
+			setAggregateFuncError(function_info, getError(errAPI, err).Error())
+			return
+		}
+	}
+}
+
+//export aggregate_udf_finalize
+func aggregate_udf_finalize(function_info C.duckdb_function_info, source *C.duckdb_aggregate_state, result C.duckdb_vector, count C.idx_t, offset C.idx_t) {
+	extraInfo := C.duckdb_aggregate_function_get_extra_info(function_info)
+	function := getPinned[AggregateFunc](extraInfo)
+
+	// Initialize the output chunk.
+	var outputChunk DataChunk
+	if err := outputChunk.initFromDuckVector(result, true); err != nil {
+		setAggregateFuncError(function_info, getError(errAPI, err).Error())
+		return
+	}
+
+	finalize := function.Executor().Finalize
+	for i, state := range unsafe.Slice(source, count) {
+		val, err := finalize(getAggregateState(state).value)
+		if err == nil {
+			err = outputChunk.SetValue(0, int(offset)+i, val)
+		}
+		if err != nil {
+			setAggregateFuncError(function_info, getError(errAPI, err).Error())
+			return
+		}
+	}
+}
+
+//export aggregate_udf_destroy
+func aggregate_udf_destroy(states *C.duckdb_aggregate_state, count C.idx_t) {
+	for _, state := range unsafe.Slice(states, count) {
+		h := stateHandle(state)
+		if *h != 0 {
+			h.Delete()
+			*h = 0
+		}
+	}
+}
+
+// stateHandle returns the handle in the memory of a state.
+func stateHandle(state C.duckdb_aggregate_state) *cgo.Handle {
+	return (*cgo.Handle)(unsafe.Pointer(state))
+}
+
+func getAggregateState(state C.duckdb_aggregate_state) *aggregateState {
+	return stateHandle(state).Value().(*aggregateState)
+}
+
+func setAggregateFuncError(function_info C.duckdb_function_info, msg string) {
+	err := C.CString(msg)
+	defer C.duckdb_free(unsafe.Pointer(err))
+	C.duckdb_aggregate_function_set_error(function_info, err)
+}
+
+func createAggregateFunc(name string, f AggregateFunc) (C.duckdb_aggregate_function, error) {
+	if name == "" {
+		return nil, errAggregateUDFNoName
+	}
+	if f == nil {
+		return nil, errAggregateUDFIsNil
+	}
+	executor := f.Executor()
+	if executor.Init == nil || executor.Update == nil || executor.Combine == nil || executor.Finalize == nil {
+		return nil, errAggregateUDFNoExecutor
+	}
+
+	// Validate the configuration before allocating the function.
+	config := f.Config()
+	for i, info := range config.InputTypeInfos {
+		if info == nil {
+			return nil, addIndexToError(errAggregateUDFInputTypeIsNil, i)
+		}
+	}
+	if config.ResultTypeInfo == nil {
+		return nil, errAggregateUDFResultTypeIsNil
+	}
+	if config.ResultTypeInfo.InternalType() == TYPE_ANY {
+		return nil, errAggregateUDFResultTypeIsANY
+	}
+
+	function := C.duckdb_create_aggregate_function()
+
+	// Set the name.
+	cName := C.CString(name)
+	defer C.duckdb_free(unsafe.Pointer(cName))
+	C.duckdb_aggregate_function_set_name(function, cName)
+
+	// Configure the aggregate function.
+	for _, info := range config.InputTypeInfos {
+		t := info.logicalType()
+		C.duckdb_aggregate_function_add_parameter(function, t)
+		C.duckdb_destroy_logical_type(&t)
+	}
+	t := config.ResultTypeInfo.logicalType()
+	C.duckdb_aggregate_function_set_return_type(function, t)
+	C.duckdb_destroy_logical_type(&t)
+	if config.SpecialNullHandling {
+		C.duckdb_aggregate_function_set_special_handling(function)
+	}
+
+	// Set the function callbacks.
+	C.duckdb_aggregate_function_set_functions(function,
+		C.aggregate_udf_state_size_t(C.aggregate_udf_state_size),
+		C.aggregate_udf_init_t(C.aggregate_udf_init),
+		C.aggregate_udf_update_t(C.aggregate_udf_update),
+		C.aggregate_udf_combine_t(C.aggregate_udf_combine),
+		C.aggregate_udf_finalize_t(C.aggregate_udf_finalize))
+	C.duckdb_aggregate_function_set_destructor(function, C.aggregate_udf_destroy_t(C.aggregate_udf_destroy))
+
+	// Pin the AggregateFunc f.
+	value := pinnedValue[AggregateFunc]{
+		pinner: &runtime.Pinner{},
+		value:  f,
+	}
+	h := cgo.NewHandle(value)
+	value.pinner.Pin(&h)
+
+	// Set the execution data, which is the AggregateFunc f.
+	C.duckdb_aggregate_function_set_extra_info(
+		function,
+		unsafe.Pointer(&h),
+		C.duckdb_delete_callback_t(C.udf_delete_callback))
+
+	return function, nil
+}
@@ -0,0 +1,327 @@
+package duckdb
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+type (
+	sumOfSquaresAUDF struct{}
+	geoMeanAUDF      struct{}
+	countNullsAUDF   struct{}
+	errExecutorAUDF  struct{}
+	errInputNilAUDF  struct{}
+	errResultNilAUDF struct{}
+	errResultAnyAUDF struct{}
+	errExecAUDF      struct{}
+	geoMeanAUDFState struct {
+		logSum float64
+		count  int64
+	}
+)
+
+func doubleTypeInfo() TypeInfo {
+	info, _ := NewTypeInfo(TYPE_DOUBLE)
+	return info
+}
+
+func bigintTypeInfo() TypeInfo {
+	info, _ := NewTypeInfo(TYPE_BIGINT)
+	return info
+}
+
+func sumOfSquaresUpdate(state any, values []driver.Value) (any, error) {
+	v := values[0].(float64)
+	return state.(float64) + v*v, nil
+}
+
+func sumCombine(target any, source any) (any, error) {
+	return target.(float64) + source.(float64), nil
+}
+
+func (*sumOfSquaresAUDF) Config() AggregateFuncConfig {
+	return AggregateFuncConfig{
+		InputTypeInfos: []TypeInfo{doubleTypeInfo()},
+		ResultTypeInfo: doubleTypeInfo(),
+	}
+}
+
+func (*sumOfSquaresAUDF) Executor() AggregateFuncExecutor {
+	return AggregateFuncExecutor{
+		Init:     func() any { return float64(0) },
+		Update:   sumOfSquaresUpdate,
+		Combine:  sumCombine,
+		Finalize: func(state any) (any, error) { return state, nil },
+	}
+}
+
+func (*geoMeanAUDF) Config() AggregateFuncConfig {
+	return AggregateFuncConfig{
+		InputTypeInfos: []TypeInfo{doubleTypeInfo()},
+		ResultTypeInfo: doubleTypeInfo(),
+	}
+}
+
+func (*geoMeanAUDF) Executor() AggregateFuncExecutor {
+	return AggregateFuncExecutor{
+		Init: func() any { return geoMeanAUDFState{} },
+		Update: func(state any, values []driver.Value) (any, error) {
+			s := state.(geoMeanAUDFState)
+			s.logSum += math.Log(values[0].(float64))
+			s.count++
+			return s, nil
+		},
+		Combine: func(target any, source any) (any, error) {
+			t, s := target.(geoMeanAUDFState), source.(geoMeanAUDFState)
+			return geoMeanAUDFState{t.logSum + s.logSum, t.count + s.count}, nil
+		},
+		Finalize: func(state any) (any, error) {
+			s := state.(geoMeanAUDFState)
+			if s.count == 0 {
+				return nil, nil
+			}
+			return math.Exp(s.logSum / float64(s.count)), nil
+		},
+	}
+}
+
+func (*countNullsAUDF) Config() AggregateFuncConfig {
+	return AggregateFuncConfig{
+		InputTypeInfos:      []TypeInfo{doubleTypeInfo()},
+		ResultTypeInfo:      bigintTypeInfo(),
+		SpecialNullHandling: true,
+	}
+}
+
+func (*countNullsAUDF) Executor() AggregateFuncExecutor {
+	return AggregateFuncExecutor{
+		Init: func() any { return int64(0) },
+		Update: func(state any, values []driver.Value) (any, error) {
+			if values[0] == nil {
+				return state.(int64) + 1, nil
+			}
+			return state, nil
+		},
+		Combine: func(target any, source any) (any, error) {
+			return target.(int64) + source.(int64), nil
+		},
+		Finalize: func(state any) (any, error) { return state, nil },
+	}
+}
+
+func (*errExecutorAUDF) Config() AggregateFuncConfig {
+	return AggregateFuncConfig{
+		InputTypeInfos: []TypeInfo{doubleTypeInfo()},
+		ResultTypeInfo: doubleTypeInfo(),
+	}
+}
+
+func (*errExecutorAUDF) Executor() AggregateFuncExecutor {
+	return AggregateFuncExecutor{Update: sumOfSquaresUpdate}
+}
+
+func (*errInputNilAUDF) Config() AggregateFuncConfig {
+	return AggregateFuncConfig{
+		InputTypeInfos: []TypeInfo{nil},
+		ResultTypeInfo: doubleTypeInfo(),
+	}
+}
+
+func (*errInputNilAUDF) Executor() AggregateFuncExecutor {
+	return (&sumOfSquaresAUDF{}).Executor()
+}
+
+func (*errResultNilAUDF) Config() AggregateFuncConfig {
+	return AggregateFuncConfig{InputTypeInfos: []TypeInfo{doubleTypeInfo()}}
+}
+
+func (*errResultNilAUDF) Executor() AggregateFuncExecutor {
+	return (&sumOfSquaresAUDF{}).Executor()
+}
+
+func (*errResultAnyAUDF) Config() AggregateFuncConfig {
+	anyTypeInfo, _ := NewTypeInfo(TYPE_ANY)
+	return AggregateFuncConfig{
+		InputTypeInfos: []TypeInfo{doubleTypeInfo()},
+		ResultTypeInfo: anyTypeInfo,
+	}
+}
+
+func (*errResultAnyAUDF) Executor() AggregateFuncExecutor {
+	return (&sumOfSquaresAUDF{}).Executor()
+}
+
+func (*errExecAUDF) Config() AggregateFuncConfig {
+	return (&sumOfSquaresAUDF{}).Config()
+}
+
+func (*errExecAUDF) Executor() AggregateFuncExecutor {
+	executor := (&sumOfSquaresAUDF{}).Executor()
+	executor.Update = func(any, []driver.Value) (any, error) {
+		return nil, errors.New("test invalid execution")
+	}
+	return executor
+}
+
+func TestSumOfSquaresAggregateUDF(t *testing.T) {
+	db, err := sql.Open("duckdb", "")
+	require.NoError(t, err)
+
+	c, err := db.Conn(context.Background())
+	require.NoError(t, err)
+
+	var udf *sumOfSquaresAUDF
+	require.NoError(t, RegisterAggregateUDF(c, "sum_of_squares", udf))
+
+	var res float64
+	row := c.QueryRowContext(context.Background(), `SELECT sum_of_squares(x) FROM (VALUES (1.0), (2.0), (NULL), (3.0)) t(x)`)
+	require.NoError(t, row.Scan(&res))
+	require.Equal(t, float64(14), res)
+
+	// Aggregate per group.
+	rows, err := c.QueryContext(context.Background(), `
+		SELECT i % 3 AS g, sum_of_squares(i::DOUBLE)
+		FROM range(10) t(i)
+		GROUP BY g
+		ORDER BY g`)
+	require.NoError(t, err)
+	expected := []float64{0 + 9 + 36 + 81, 1 + 16 + 49, 4 + 25 + 64}
+	var g int64
+	for i := 0; rows.Next(); i++ {
+		require.NoError(t, rows.Scan(&g, &res))
+		require.Equal(t, expected[i], res)
+	}
+	require.NoError(t, rows.Err())
+	require.NoError(t, rows.Close())
+
+	// Aggregate enough rows for DuckDB to update and combine states in parallel.
+	_, err = c.ExecContext(context.Background(), `SET threads = 4`)
+	require.NoError(t, err)
+	row = c.QueryRowContext(context.Background(), `
+		SELECT sum_of_squares((i % 100)::DOUBLE) = sum(((i % 100) * (i % 100))::DOUBLE)
+		FROM range(1000000) t(i)`)
+	var equal bool
+	require.NoError(t, row.Scan(&equal))
+	require.True(t, equal)
+
+	row = c.QueryRowContext(context.Background(), `
+		SELECT count(*) FROM (
+			SELECT i % 1000 AS g, sum_of_squares(1.0) AS s
+			FROM range(1000000) t(i)
+			GROUP BY g
+		) WHERE s = 1000`)
+	var count int64
+	require.NoError(t, row.Scan(&count))
+	require.Equal(t, int64(1000), count)
+
+	require.NoError(t, c.Close())
+	require.NoError(t, db.Close())
+}
+
+func TestGeoMeanAggregateUDF(t *testing.T) {
+	db, err := sql.Open("duckdb", "")
+	require.NoError(t, err)
+
+	c, err := db.Conn(context.Background())
+	require.NoError(t, err)
+
+	var udf *geoMeanAUDF
+	require.NoError(t, RegisterAggregateUDF(c, "geo_mean", udf))
+
+	var res float64
+	row := c.QueryRowContext(context.Background(), `SELECT geo_mean(x) FROM (VALUES (2.0), (8.0)) t(x)`)
+	require.NoError(t, row.Scan(&res))
+	require.InDelta(t, 4, res, 1e-9)
+
+	// The aggregate of an empty group is NULL.
+	var null *float64
+	row = c.QueryRowContext(context.Background(), `SELECT geo_mean(x) FROM (VALUES (2.0)) t(x) WHERE x > 10`)
+	require.NoError(t, row.Scan(&null))
+	require.Nil(t, null)
+
+	require.NoError(t, c.Close())
+	require.NoError(t, db.Close())
+}
+
+func TestSpecialNullHandlingAggregateUDF(t *testing.T) {
+	db, err := sql.Open("duckdb", "")
+	require.NoError(t, err)
+
+	c, err := db.Conn(context.Background())
+	require.NoError(t, err)
+
+	var udf *countNullsAUDF
+	require.NoError(t, RegisterAggregateUDF(c, "count_nulls", udf))
+
+	var res int64
+	row := c.QueryRowContext(context.Background(), `SELECT count_nulls(x) FROM (VALUES (1.0), (NULL), (NULL)) t(x)`)
+	require.NoError(t, row.Scan(&res))
+	require.Equal(t, int64(2), res)
+
+	require.NoError(t, c.Close())
+	require.NoError(t, db.Close())
+}
+
+func TestErrAggregateUDF(t *testing.T) {
+	t.Parallel()
+
+	db, err := sql.Open("duckdb", "")
+	require.NoError(t, err)
+
+	c, err := db.Conn(context.Background())
+	require.NoError(t, err)
+
+	// Empty name.
+	var emptyNameUDF *sumOfSquaresAUDF
+	err = RegisterAggregateUDF(c, "", emptyNameUDF)
+	testError(t, err, errAPI.Error(), errAggregateUDFCreate.Error(), errAggregateUDFNoName.Error())
+
+	// Invalid executor.
+	var errExecutorUDF *errExecutorAUDF
+	err = RegisterAggregateUDF(c, "err_executor_is_nil", errExecutorUDF)
+	testError(t, err, errAPI.Error(), errAggregateUDFCreate.Error(), errAggregateUDFNoExecutor.Error())
+
+	// Invalid input parameter.
+	var errInputNilUDF *errInputNilAUDF
+	err = RegisterAggregateUDF(c, "err_input_type_is_nil", errInputNilUDF)
+	testError(t, err, errAPI.Error(), errAggregateUDFCreate.Error(), errAggregateUDFInputTypeIsNil.Error())
+
+	// Invalid result parameters.
+	var errResultNil *errResultNilAUDF
+	err = RegisterAggregateUDF(c, "err_result_type_is_nil", errResultNil)
+	testError(t, err, errAPI.Error(), errAggregateUDFCreate.Error(), errAggregateUDFResultTypeIsNil.Error())
+	var errResultAny *errResultAnyAUDF
+	err = RegisterAggregateUDF(c, "err_result_type_is_any", errResultAny)
+	testError(t, err, errAPI.Error(), errAggregateUDFCreate.Error(), errAggregateUDFResultTypeIsANY.Error())
+
+	// Error during execution.
+	var errExecUDF *errExecAUDF
+	err = RegisterAggregateUDF(c, "err_exec", errExecUDF)
+	require.NoError(t, err)
+	row := c.QueryRowContext(context.Background(), `SELECT err_exec(x) FROM (VALUES (1.0)) t(x)`)
+	testError(t, row.Err(), errAPI.Error())
+
+	// Register an aggregate function whose name already exists.
+	var udf *sumOfSquaresAUDF
+	err = RegisterAggregateUDF(c, "my_sum_of_squares", udf)
+	require.NoError(t, err)
+	err = RegisterAggregateUDF(c, "my_sum_of_squares", udf)
+	testError(t, err, errAPI.Error(), errAggregateUDFCreate.Error())
+
+	// Register an aggregate function that is nil.
+	err = RegisterAggregateUDF(c, "my_sum_of_squares", nil)
+	testError(t, err, errAPI.Error(), errAggregateUDFIsNil.Error())
+	require.NoError(t, c.Close())
+
+	// Test registering the aggregate function on a closed connection.
+	var errClosedConUDF *sumOfSquaresAUDF
+	err = RegisterAggregateUDF(c, "closed_con", errClosedConUDF)
+	require.ErrorContains(t, err, sql.ErrConnDone.Error())
+	require.NoError(t, db.Close())
+}
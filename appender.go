@@ -6,14 +6,35 @@ package duckdb
 import "C"
 
 import (
+	"context"
+	"database/sql"
 	"database/sql/driver"
 	"errors"
+	"fmt"
+	"io"
+	"reflect"
+	"strings"
+	"time"
 	"unsafe"
 )
 
+// Default is a placeholder value for AppendRow, which appends the DEFAULT value of its column.
+// The appender evaluates the DEFAULT expression once per appended value when flushing,
+// e.g., a DEFAULT nextval('seq') column receives a new sequence value for each row.
+var Default = appenderDefault{}
+
+type appenderDefault struct{}
+
+// columnDefault is the DEFAULT expression of a column and the type of the column.
+type columnDefault struct {
+	expr     string
+	typeName string
+}
+
 // Appender holds the DuckDB appender. It allows efficient bulk loading into a DuckDB database.
 type Appender struct {
 	con            *conn
+	catalog        string
 	schema         string
 	table          string
 	duckdbAppender C.duckdb_appender
@@ -23,14 +44,33 @@ type Appender struct {
 	chunks []DataChunk
 	// The column types of the table to append to.
 	types []C.duckdb_logical_type
+	// The column names of the table to append to. Lazily initialized by AppendStructs and Default values.
+	names []string
+	// The DEFAULT expressions of the columns that have one, by column index. Initialized with names.
+	defaults map[int]columnDefault
+	// The names of the GENERATED columns, which DuckDB computes, and which the appender skips. Initialized with names.
+	generated []string
+	// The row indexes of the appended Default values, by column index.
+	defaultRows map[int][]int
 	// A pointer to the allocated memory of the column types.
 	ptr unsafe.Pointer
 	// The number of appended rows.
 	rowCount int
+	// The number of rows after which the appender flushes automatically. Zero disables automatic flushing.
+	flushThreshold int
+	// flushErr holds the error of a failed flush or AppendFromChannel, which invalidates the appender.
+	flushErr error
 }
 
 // NewAppenderFromConn returns a new Appender from a DuckDB driver connection.
 func NewAppenderFromConn(driverConn driver.Conn, schema, table string) (*Appender, error) {
+	return NewAppenderWithSchema(driverConn, "", schema, table)
+}
+
+// NewAppenderWithSchema returns a new Appender from a DuckDB driver connection.
+// The catalog is the name of an (attached) database. An empty catalog selects the default catalog
+// of the connection, and an empty schema selects its current schema, i.e., the first schema of its search_path.
+func NewAppenderWithSchema(driverConn driver.Conn, catalog, schema, table string) (*Appender, error) {
 	con, ok := driverConn.(*conn)
 	if !ok {
 		return nil, getError(errInvalidCon, nil)
@@ -39,31 +79,42 @@ func NewAppenderFromConn(driverConn driver.Conn, schema, table string) (*Appende
 		return nil, getError(errClosedCon, nil)
 	}
 
-	var cSchema *C.char
-	if schema != "" {
-		cSchema = C.CString(schema)
-		defer C.duckdb_free(unsafe.Pointer(cSchema))
-	}
-
 	cTable := C.CString(table)
 	defer C.duckdb_free(unsafe.Pointer(cTable))
 
 	var duckdbAppender C.duckdb_appender
-	state := C.duckdb_appender_create(con.duckdbCon, cSchema, cTable, &duckdbAppender)
+	err := useCatalog(con, catalog, func() error {
+		// The C API defaults to the main schema, so we resolve an empty schema to the current schema.
+		if schema == "" {
+			var err error
+			if schema, err = currentSchema(con); err != nil {
+				return err
+			}
+		}
+		cSchema := C.CString(schema)
+		defer C.duckdb_free(unsafe.Pointer(cSchema))
 
-	if state == C.DuckDBError {
-		// We destroy the error message when destroying the appender.
-		err := duckdbError(C.duckdb_appender_error(duckdbAppender))
-		C.duckdb_appender_destroy(&duckdbAppender)
+		state := C.duckdb_appender_create(con.duckdbCon, cSchema, cTable, &duckdbAppender)
+		if state == C.DuckDBError {
+			// We destroy the error message when destroying the appender.
+			err := appenderCreationError(C.duckdb_appender_error(duckdbAppender))
+			C.duckdb_appender_destroy(&duckdbAppender)
+			return err
+		}
+		return nil
+	})
+	if err != nil {
 		return nil, getError(errAppenderCreation, err)
 	}
 
 	a := &Appender{
 		con:            con,
+		catalog:        catalog,
 		schema:         schema,
 		table:          table,
 		duckdbAppender: duckdbAppender,
 		rowCount:       0,
+		defaultRows:    make(map[int][]int),
 	}
 
 	// Get the column types.
@@ -86,22 +137,122 @@ func NewAppenderFromConn(driverConn driver.Conn, schema, table string) (*Appende
 	return a, nil
 }
 
+// ColumnCount returns the number of columns, which each appended row must have.
+// It excludes GENERATED columns, which the appender skips.
+func (a *Appender) ColumnCount() int {
+	return len(a.types)
+}
+
+// ColumnTypes returns the full DuckDB types of the columns, which each appended row must have, e.g., DECIMAL(18,4),
+// as returned by sql.ColumnType.DatabaseTypeName. It excludes GENERATED columns, which the appender skips.
+func (a *Appender) ColumnTypes() []string {
+	names := make([]string, len(a.types))
+	for i, t := range a.types {
+		names[i] = logicalTypeName(t)
+	}
+	return names
+}
+
 // Flush the data chunks to the underlying table and clear the internal cache.
-// Does not close the appender, even if it returns an error. Unless you have a good reason to call this,
+// After a successful Flush, the appender remains usable, and appending continues with the next row.
+// A failed Flush, e.g., due to a constraint violation, invalidates the appender: the rows of the failed Flush
+// are lost, and subsequent appends and flushes fail with the error of the Flush. It does not close the appender,
+// so you must still call Close. Unless you have a good reason to call this,
 // call Close when you are done with the appender.
 func (a *Appender) Flush() error {
+	if a.flushErr != nil {
+		return getError(errAppenderFlush, a.flushErr)
+	}
+	if err := a.flush(); err != nil {
+		a.flushErr = err
+		return getError(errAppenderFlush, err)
+	}
+	return nil
+}
+
+func (a *Appender) flush() error {
 	if err := a.appendDataChunks(); err != nil {
-		return getError(errAppenderFlush, invalidatedAppenderError(err))
+		return invalidatedAppenderError(err)
 	}
 
-	state := C.duckdb_appender_flush(a.duckdbAppender)
-	if state == C.DuckDBError {
-		err := duckdbError(C.duckdb_appender_error(a.duckdbAppender))
-		return getError(errAppenderFlush, invalidatedAppenderError(err))
+	if err := a.flushAppender(); err != nil {
+		return invalidatedAppenderError(err)
 	}
 	return nil
 }
 
+// flushAppender flushes the DuckDB appender within the catalog of the appender.
+func (a *Appender) flushAppender() error {
+	return useCatalog(a.con, a.catalog, func() error {
+		state := C.duckdb_appender_flush(a.duckdbAppender)
+		if state == C.DuckDBError {
+			return appenderError(C.duckdb_appender_error(a.duckdbAppender))
+		}
+		return nil
+	})
+}
+
+// useCatalog runs f with catalog as the default catalog of the connection, and then restores the previous default.
+// NOTE: The C API of DuckDB v1.1 does not allow passing a catalog to the appender.
+// Instead, the appender resolves its table in the default catalog, both when creating and when flushing it.
+// USE also overwrites the search_path setting, so we reset it and then restore its previous value.
+// We execute these internal statements without passing them to the QueryLogger of the connection.
+func useCatalog(con *conn, catalog string, f func() error) error {
+	if catalog == "" {
+		return f()
+	}
+
+	ctx := context.Background()
+	res, err := con.queryContext(ctx, `SELECT current_database(), current_schema(), current_setting('search_path')`, nil)
+	if err != nil {
+		return err
+	}
+	values := make([]driver.Value, 3)
+	err = res.Next(values)
+	errClose := res.Close()
+	if err = errors.Join(err, errClose); err != nil {
+		return err
+	}
+
+	if _, err = con.execContext(ctx, `USE `+quoteIdentifier(catalog), nil); err != nil {
+		return err
+	}
+	err = f()
+
+	prev := quoteIdentifier(values[0].(string)) + "." + quoteIdentifier(values[1].(string))
+	_, errRestore := con.execContext(ctx, `USE `+prev+`; RESET search_path`, nil)
+	if searchPath := values[2].(string); errRestore == nil && searchPath != "" {
+		_, errRestore = con.execContext(ctx, `SET search_path = `+quoteString(searchPath), nil)
+	}
+	return errors.Join(err, errRestore)
+}
+
+// currentSchema returns the current schema of the connection, i.e., the first schema of its search_path.
+func currentSchema(con *conn) (string, error) {
+	res, err := con.queryContext(context.Background(), `SELECT current_schema()`, nil)
+	if err != nil {
+		return "", err
+	}
+	values := make([]driver.Value, 1)
+	err = res.Next(values)
+	if err = errors.Join(err, res.Close()); err != nil {
+		return "", err
+	}
+	return values[0].(string), nil
+}
+
+// SetFlushThreshold makes the appender flush automatically after each n appended rows.
+// A threshold of zero, which is the default, disables automatic flushing. Close flushes any remaining rows.
+// If an automatic flush fails, then the appender is invalidated, and any subsequent append returns an error.
+// NOTE: The appender appends within the transaction of its connection.
+// Thus, if the connection has an open transaction, then the flushed rows only persist when committing that transaction.
+func (a *Appender) SetFlushThreshold(n int) {
+	if n < 0 {
+		n = 0
+	}
+	a.flushThreshold = n
+}
+
 // Close the appender. This will flush the appender to the underlying table.
 // It is vital to call this when you are done with the appender to avoid leaking memory.
 func (a *Appender) Close() error {
@@ -114,16 +265,12 @@ func (a *Appender) Close() error {
 	errAppend := a.appendDataChunks()
 
 	// We flush before closing to get a meaningful error message.
-	var errFlush error
-	state := C.duckdb_appender_flush(a.duckdbAppender)
-	if state == C.DuckDBError {
-		errFlush = duckdbError(C.duckdb_appender_error(a.duckdbAppender))
-	}
+	errFlush := a.flushAppender()
 
 	// Destroy all appender data and the appender.
 	destroyTypeSlice(a.ptr, a.types)
 	var errClose error
-	state = C.duckdb_appender_destroy(&a.duckdbAppender)
+	state := C.duckdb_appender_destroy(&a.duckdbAppender)
 	if state == C.DuckDBError {
 		errClose = errAppenderClose
 	}
@@ -136,18 +283,199 @@ func (a *Appender) Close() error {
 }
 
 // AppendRow loads a row of values into the appender. The values are provided as separate arguments.
+// A nil value, or a nil pointer, slice, or map, appends NULL, also within nested values, and pointers append their values.
 func (a *Appender) AppendRow(args ...driver.Value) error {
 	if a.closed {
 		return getError(errAppenderAppendAfterClose, nil)
 	}
+	if a.flushErr != nil {
+		return getError(errAppenderAppendAfterFlushErr, a.flushErr)
+	}
 
 	err := a.appendRowSlice(args)
+	if err == nil {
+		err = a.autoFlush()
+	}
+	if err != nil {
+		return getError(errAppenderAppendRow, err)
+	}
+	return nil
+}
+
+// channelFlushChunks is the number of data chunks after which AppendFromChannel flushes,
+// if the appender has no flush threshold.
+const channelFlushChunks = 100
+
+// AppendFromChannel appends each row received from ch until ch is closed, or until ctx is done.
+// A row holds one value per column, like the arguments of AppendRow.
+// AppendFromChannel flushes after every SetFlushThreshold rows, or, without a threshold, after every
+// 100 data chunks, and it flushes the remaining rows before returning. If ctx is done, it returns ctx.Err().
+// An error stops consuming ch, and invalidates the appender, which must then be closed.
+func (a *Appender) AppendFromChannel(ctx context.Context, ch <-chan []any) error {
+	if a.closed {
+		return getError(errAppenderAppendAfterClose, nil)
+	}
+	if a.flushErr != nil {
+		return getError(errAppenderAppendAfterFlushErr, a.flushErr)
+	}
+
+	threshold := a.flushThreshold
+	if threshold == 0 {
+		threshold = channelFlushChunks * GetDataChunkCapacity()
+	}
+
+	var args []driver.Value
+	for {
+		select {
+		case <-ctx.Done():
+			if err := a.flush(); err != nil {
+				a.flushErr = err
+				return errors.Join(ctx.Err(), getError(errAppenderFlush, err))
+			}
+			return ctx.Err()
+		case row, ok := <-ch:
+			if !ok {
+				if err := a.flush(); err != nil {
+					a.flushErr = err
+					return getError(errAppenderFlush, err)
+				}
+				return nil
+			}
+
+			args = args[:0]
+			for _, v := range row {
+				args = append(args, v)
+			}
+			err := a.appendRowSlice(args)
+			if err == nil {
+				err = a.flushAt(threshold)
+			}
+			if err != nil {
+				a.flushErr = err
+				return getError(errAppenderAppendRow, err)
+			}
+		}
+	}
+}
+
+// AppendStructs loads a slice of structs into the appender. Each struct is appended as one row.
+// An exported struct field maps to the table column with the same name, or to the column set in its `db` tag.
+// A `db:"-"` tag skips the field. The struct fields must match the table's columns. Structs may omit columns with a DEFAULT value,
+// e.g., an id column with DEFAULT nextval('seq'), which receive their DEFAULT value.
+func (a *Appender) AppendStructs(rows any) error {
+	if a.closed {
+		return getError(errAppenderAppendAfterClose, nil)
+	}
+	if a.flushErr != nil {
+		return getError(errAppenderAppendAfterFlushErr, a.flushErr)
+	}
+
+	err := a.appendStructSlice(rows)
 	if err != nil {
 		return getError(errAppenderAppendRow, err)
 	}
 	return nil
 }
 
+// CopyRows appends all remaining rows of rows to the appender, e.g., to materialize a query result into a table.
+// The rows must have one column per appender column. CopyRows scans each value into the Go type of its target column,
+// which converts values of other drivers, e.g., an int64 for an INTEGER column, and appends NULL values as NULL.
+// It does not close rows, and it does not flush the appender.
+func CopyRows(appender *Appender, rows *sql.Rows) error {
+	columns, err := rows.Columns()
+	if err != nil {
+		return err
+	}
+	if len(columns) != len(appender.types) {
+		return getError(errAppenderAppendRow, columnCountError(len(columns), len(appender.types)))
+	}
+
+	// Scan each value into a pointer to a pointer, which is nil for NULL values.
+	dest := make([]any, len(columns))
+	for i, logicalType := range appender.types {
+		scanType := copyRowsScanType(Type(C.duckdb_get_type_id(logicalType)))
+		dest[i] = reflect.New(reflect.PointerTo(scanType)).Interface()
+	}
+
+	args := make([]driver.Value, len(columns))
+	for rows.Next() {
+		if err = rows.Scan(dest...); err != nil {
+			return err
+		}
+		for i := range dest {
+			ptr := reflect.ValueOf(dest[i]).Elem()
+			if ptr.IsNil() {
+				args[i] = nil
+				continue
+			}
+			args[i] = ptr.Elem().Interface()
+		}
+		if err = appender.AppendRow(args...); err != nil {
+			return err
+		}
+	}
+	return rows.Err()
+}
+
+// copyRowsScanType returns the Go type, into which CopyRows scans the values of a column of type t.
+// Other types pass through as they are, as database/sql cannot convert to them.
+func copyRowsScanType(t Type) reflect.Type {
+	switch t {
+	case TYPE_BOOLEAN:
+		return reflect.TypeOf(true)
+	case TYPE_TINYINT:
+		return reflect.TypeOf(int8(0))
+	case TYPE_SMALLINT:
+		return reflect.TypeOf(int16(0))
+	case TYPE_INTEGER:
+		return reflect.TypeOf(int32(0))
+	case TYPE_BIGINT:
+		return reflect.TypeOf(int64(0))
+	case TYPE_UTINYINT:
+		return reflect.TypeOf(uint8(0))
+	case TYPE_USMALLINT:
+		return reflect.TypeOf(uint16(0))
+	case TYPE_UINTEGER:
+		return reflect.TypeOf(uint32(0))
+	case TYPE_UBIGINT:
+		return reflect.TypeOf(uint64(0))
+	case TYPE_FLOAT:
+		return reflect.TypeOf(float32(0))
+	case TYPE_DOUBLE:
+		return reflect.TypeOf(float64(0))
+	case TYPE_VARCHAR:
+		return reflect.TypeOf("")
+	case TYPE_BLOB:
+		return reflect.TypeOf([]byte{})
+	case TYPE_TIMESTAMP, TYPE_TIMESTAMP_S, TYPE_TIMESTAMP_MS, TYPE_TIMESTAMP_NS, TYPE_TIMESTAMP_TZ, TYPE_DATE:
+		return reflect.TypeOf(time.Time{})
+	default:
+		return reflect.TypeOf((*any)(nil)).Elem()
+	}
+}
+
+// autoFlush flushes the appender, if the number of unflushed rows reached the flush threshold.
+func (a *Appender) autoFlush() error {
+	return a.flushAt(a.flushThreshold)
+}
+
+// flushAt flushes the appender, if the number of unflushed rows reached threshold.
+// A failed flush invalidates the appender.
+func (a *Appender) flushAt(threshold int) error {
+	if threshold == 0 || len(a.chunks) == 0 {
+		return nil
+	}
+	if (len(a.chunks)-1)*GetDataChunkCapacity()+a.rowCount < threshold {
+		return nil
+	}
+
+	if err := a.flush(); err != nil {
+		a.flushErr = err
+		return fmt.Errorf("%w: %w", errAppenderFlush, err)
+	}
+	return nil
+}
+
 func (a *Appender) addDataChunk() error {
 	var chunk DataChunk
 	if err := chunk.initFromTypes(a.ptr, a.types, true); err != nil {
@@ -157,12 +485,7 @@ func (a *Appender) addDataChunk() error {
 	return nil
 }
 
-func (a *Appender) appendRowSlice(args []driver.Value) error {
-	// Early-out, if the number of args does not match the column count.
-	if len(args) != len(a.types) {
-		return columnCountError(len(args), len(a.types))
-	}
-
+func (a *Appender) prepareRow() error {
 	// Create a new data chunk if the current chunk is full.
 	if a.rowCount == GetDataChunkCapacity() || len(a.chunks) == 0 {
 		if err := a.addDataChunk(); err != nil {
@@ -170,25 +493,322 @@ func (a *Appender) appendRowSlice(args []driver.Value) error {
 		}
 		a.rowCount = 0
 	}
+	return nil
+}
+
+// discardRow resets the NULL values of a partially appended row, which the next row overwrites.
+func (a *Appender) discardRow() {
+	chunk := &a.chunks[len(a.chunks)-1]
+	for i := range chunk.columns {
+		chunk.columns[i].setValid(C.idx_t(a.rowCount))
+	}
+}
+
+func (a *Appender) appendRowSlice(args []driver.Value) error {
+	// Early-out, if the number of args does not match the column count.
+	if len(args) != len(a.types) {
+		err := columnCountError(len(args), len(a.types))
+		// Explain the mismatch, if the args include values for GENERATED columns.
+		if _, namesErr := a.columnNames(); namesErr == nil && len(args) > len(a.types) && len(a.generated) != 0 {
+			err = fmt.Errorf("%w: %w", err, generatedColumnsError(a.generated))
+		}
+		return err
+	}
+
+	if err := a.prepareRow(); err != nil {
+		return err
+	}
 
 	// Set all values.
+	var defaultCols []int
 	for i, val := range args {
 		chunk := &a.chunks[len(a.chunks)-1]
-		err := chunk.SetValue(i, a.rowCount, val)
+		var err error
+		if _, ok := val.(appenderDefault); ok {
+			// We set the DEFAULT values when flushing.
+			err = a.checkDefault(i)
+			defaultCols = append(defaultCols, i)
+		} else {
+			err = chunk.SetValue(i, a.rowCount, val)
+		}
 		if err != nil {
-			return err
+			a.discardRow()
+			return columnError(err, i)
 		}
 	}
 
+	rowIdx := (len(a.chunks)-1)*GetDataChunkCapacity() + a.rowCount
+	for _, i := range defaultCols {
+		a.defaultRows[i] = append(a.defaultRows[i], rowIdx)
+	}
+
 	a.rowCount++
 	return nil
 }
 
+// checkDefault returns an error, if the column has no DEFAULT value.
+func (a *Appender) checkDefault(colIdx int) error {
+	if _, err := a.columnNames(); err != nil {
+		return err
+	}
+	if _, ok := a.defaults[colIdx]; !ok {
+		return errAppenderNoDefault
+	}
+	return nil
+}
+
+// setDefaultValues evaluates the DEFAULT expressions of all appended Default values, and sets them in the data chunks.
+func (a *Appender) setDefaultValues() error {
+	defer clear(a.defaultRows)
+
+	for colIdx, rowIdxs := range a.defaultRows {
+		def := a.defaults[colIdx]
+		query := fmt.Sprintf(`SELECT CAST((%s) AS %s) FROM range(%d)`, def.expr, def.typeName, len(rowIdxs))
+
+		var res driver.Rows
+		err := useCatalog(a.con, a.catalog, func() error {
+			var err error
+			res, err = a.con.QueryContext(context.Background(), query, nil)
+			return err
+		})
+		if err != nil {
+			return columnError(err, colIdx)
+		}
+
+		values := make([]driver.Value, 1)
+		for _, rowIdx := range rowIdxs {
+			if err = res.Next(values); err != nil {
+				break
+			}
+			chunk := &a.chunks[rowIdx/GetDataChunkCapacity()]
+			if err = chunk.SetValue(colIdx, rowIdx%GetDataChunkCapacity(), values[0]); err != nil {
+				break
+			}
+		}
+		if err = errors.Join(err, res.Close()); err != nil {
+			return columnError(err, colIdx)
+		}
+	}
+	return nil
+}
+
+func (a *Appender) appendStructSlice(rows any) error {
+	rv := reflect.ValueOf(rows)
+	if !rv.IsValid() {
+		return castError("<nil>", "[]struct")
+	}
+	if rv.Kind() != reflect.Slice && rv.Kind() != reflect.Array {
+		return castError(rv.Type().String(), "[]struct")
+	}
+	structType := rv.Type().Elem()
+	if structType.Kind() != reflect.Struct {
+		return castError(rv.Type().String(), "[]struct")
+	}
+
+	// Map the struct fields to the columns once.
+	fieldIdxs, err := a.structFieldIdxs(structType)
+	if err != nil {
+		return err
+	}
+
+	for rowIdx := 0; rowIdx < rv.Len(); rowIdx++ {
+		if err = a.prepareRow(); err != nil {
+			return err
+		}
+
+		row := rv.Index(rowIdx)
+		chunk := &a.chunks[len(a.chunks)-1]
+		for colIdx, fieldIdx := range fieldIdxs {
+			if fieldIdx == -1 {
+				continue
+			}
+			val := derefValue(&chunk.columns[colIdx], row.Field(fieldIdx))
+			if err = chunk.SetValue(colIdx, a.rowCount, val); err != nil {
+				a.discardRow()
+				err = structFieldNameError(err, structType.Field(fieldIdx).Name)
+				return addIndexToError(err, rowIdx)
+			}
+		}
+
+		// We set the DEFAULT values of omitted columns when flushing.
+		defaultRowIdx := (len(a.chunks)-1)*GetDataChunkCapacity() + a.rowCount
+		for colIdx, fieldIdx := range fieldIdxs {
+			if fieldIdx == -1 {
+				a.defaultRows[colIdx] = append(a.defaultRows[colIdx], defaultRowIdx)
+			}
+		}
+		a.rowCount++
+
+		if err = a.autoFlush(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// structFieldIdxs returns the index of the struct field for each column.
+func (a *Appender) structFieldIdxs(structType reflect.Type) ([]int, error) {
+	names, err := a.columnNames()
+	if err != nil {
+		return nil, err
+	}
+
+	fields := make(map[string]int)
+	for i := 0; i < structType.NumField(); i++ {
+		field := structType.Field(i)
+		if !field.IsExported() {
+			continue
+		}
+		name := field.Name
+		if tag, ok := field.Tag.Lookup("db"); ok {
+			if tag == "-" {
+				continue
+			}
+			name = tag
+		}
+		if _, ok := fields[name]; ok {
+			return nil, duplicateNameError(name)
+		}
+		fields[name] = i
+	}
+
+	for _, name := range a.generated {
+		for fieldName := range fields {
+			if strings.EqualFold(fieldName, name) {
+				return nil, generatedColumnsError([]string{name})
+			}
+		}
+	}
+
+	// Structs may omit columns with a DEFAULT value.
+	if len(fields) > len(names) || len(fields) < len(names)-len(a.defaults) {
+		return nil, columnCountError(len(fields), len(names))
+	}
+
+	fieldIdxs := make([]int, len(names))
+	mapped := make(map[int]bool, len(fields))
+	for colIdx, name := range names {
+		idx, ok := fields[name]
+		if !ok {
+			// DuckDB column names are case-insensitive.
+			for fieldName, i := range fields {
+				if strings.EqualFold(fieldName, name) {
+					idx, ok = i, true
+					break
+				}
+			}
+		}
+		if !ok {
+			if _, hasDefault := a.defaults[colIdx]; !hasDefault {
+				return nil, structFieldError("missing field", name)
+			}
+			// We append the DEFAULT value of the column.
+			idx = -1
+		}
+		fieldIdxs[colIdx] = idx
+		mapped[idx] = true
+	}
+
+	for name, i := range fields {
+		if !mapped[i] {
+			return nil, unexpectedStructFieldError(name)
+		}
+	}
+	return fieldIdxs, nil
+}
+
+// columnNames returns the column names of the appender's table, excluding GENERATED columns.
+// It also initializes the DEFAULT expressions and the GENERATED column names.
+func (a *Appender) columnNames() ([]string, error) {
+	if a.names != nil {
+		return a.names, nil
+	}
+
+	const query = `SELECT column_name, column_default, data_type FROM duckdb_columns()
+		WHERE database_name = coalesce(nullif($3, ''), current_database())
+			AND lower(schema_name) = lower($1) AND lower(table_name) = lower($2)
+		ORDER BY column_index`
+	args := []driver.NamedValue{{Ordinal: 1, Value: a.schema}, {Ordinal: 2, Value: a.table}, {Ordinal: 3, Value: a.catalog}}
+	res, err := a.con.QueryContext(context.Background(), query, args)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Close()
+
+	var columns [][]driver.Value
+	for {
+		values := make([]driver.Value, 3)
+		if err = res.Next(values); err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		columns = append(columns, values)
+	}
+
+	names := make([]string, 0, len(a.types))
+	defaults := make(map[int]columnDefault)
+	var generated []string
+	for _, values := range columns {
+		name := values[0].(string)
+		expr, ok := values[1].(string)
+		if ok && len(columns) > len(a.types) {
+			// duckdb_columns reports the expression of a GENERATED column as its DEFAULT.
+			// The appender skips GENERATED columns, so we only need to check, if it has fewer columns.
+			isGenerated, err := a.isGeneratedColumn(name)
+			if err != nil {
+				return nil, err
+			}
+			if isGenerated {
+				generated = append(generated, name)
+				continue
+			}
+		}
+		if ok {
+			defaults[len(names)] = columnDefault{expr: expr, typeName: values[2].(string)}
+		}
+		names = append(names, name)
+	}
+
+	if len(names) != len(a.types) {
+		return nil, columnCountError(len(names), len(a.types))
+	}
+	a.names = names
+	a.defaults = defaults
+	a.generated = generated
+	return names, nil
+}
+
+// isGeneratedColumn returns true, if the column is a GENERATED column.
+// The DuckDB catalog does not expose this, but DuckDB fails to bind inserting into a GENERATED column.
+// Any other error of the probe is returned, so that it cannot drop a column from the appender.
+func (a *Appender) isGeneratedColumn(name string) (bool, error) {
+	table := quoteIdentifier(a.schema) + "." + quoteIdentifier(a.table)
+	if a.catalog != "" {
+		table = quoteIdentifier(a.catalog) + "." + table
+	}
+
+	query := fmt.Sprintf(`INSERT INTO %s (%s) SELECT NULL WHERE false`, table, quoteIdentifier(name))
+	stmt, err := a.con.prepareStmt(query)
+	if GetErrorType(err) == ErrorTypeBinder && strings.Contains(strings.ToLower(err.Error()), "generated column") {
+		return true, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	return false, stmt.Close()
+}
+
 func (a *Appender) appendDataChunks() error {
 	var state C.duckdb_state
-	var err error
+	err := a.setDefaultValues()
 
 	for i, chunk := range a.chunks {
+		if err != nil {
+			break
+		}
+
 		// All data chunks except the last are at maximum capacity.
 		size := GetDataChunkCapacity()
 		if i == len(a.chunks)-1 {
@@ -200,7 +820,7 @@ func (a *Appender) appendDataChunks() error {
 
 		state = C.duckdb_append_data_chunk(a.duckdbAppender, chunk.data)
 		if state == C.DuckDBError {
-			err = duckdbError(C.duckdb_appender_error(a.duckdbAppender))
+			err = appenderError(C.duckdb_appender_error(a.duckdbAppender))
 			break
 		}
 	}
@@ -6,8 +6,10 @@ import (
 	"database/sql/driver"
 	"encoding/json"
 	"fmt"
+	"math"
 	"math/big"
 	"math/rand"
+	"path/filepath"
 	"reflect"
 	"testing"
 	"time"
@@ -186,6 +188,43 @@ func TestAppendChunks(t *testing.T) {
 	cleanupAppender(t, c, con, a)
 }
 
+func TestAppenderAppendStructs(t *testing.T) {
+	t.Parallel()
+	c, con, a := prepareAppender(t, `CREATE TABLE test (id BIGINT, name VARCHAR, tags VARCHAR[])`)
+
+	type row struct {
+		Name  string
+		Tags  []string
+		ID    int64 `db:"id"`
+		other int
+	}
+
+	rowsToAppend := make([]row, GetDataChunkCapacity()+10)
+	for i := range rowsToAppend {
+		rowsToAppend[i] = row{ID: int64(i), Name: fmt.Sprintf("name_%d", i), Tags: []string{"a", "b"}}
+	}
+	rowsToAppend[1].Tags = nil
+	require.NoError(t, a.AppendStructs(rowsToAppend))
+	require.NoError(t, a.Flush())
+
+	// Verify results.
+	res, err := sql.OpenDB(c).QueryContext(context.Background(), `SELECT id, name, tags FROM test ORDER BY id`)
+	require.NoError(t, err)
+
+	i := 0
+	for res.Next() {
+		var r row
+		var tags Composite[[]string]
+		require.NoError(t, res.Scan(&r.ID, &r.Name, &tags))
+		r.Tags = tags.Get()
+		require.Equal(t, rowsToAppend[i], r)
+		i++
+	}
+	require.Equal(t, len(rowsToAppend), i)
+	require.NoError(t, res.Close())
+	cleanupAppender(t, c, con, a)
+}
+
 func TestAppenderList(t *testing.T) {
 	t.Parallel()
 	c, con, a := prepareAppender(t, `
@@ -448,6 +487,53 @@ func TestAppenderNullIntAndString(t *testing.T) {
 	cleanupAppender(t, c, con, a)
 }
 
+func TestAppenderBool(t *testing.T) {
+	t.Parallel()
+	c, con, a := prepareAppender(t, `CREATE TABLE test (id INTEGER, b BOOLEAN, l BOOLEAN[])`)
+
+	// A *bool appends its value, and a nil *bool appends NULL, also within lists.
+	yes, no := true, false
+	var null *bool
+	require.NoError(t, a.AppendRow(int32(0), &yes, []*bool{&yes, nil}))
+	require.NoError(t, a.AppendRow(int32(1), &no, []*bool{&no}))
+	require.NoError(t, a.AppendRow(int32(2), null, nil))
+	require.NoError(t, a.Flush())
+
+	db := sql.OpenDB(c)
+	res, err := db.Query(`SELECT b, b, l FROM test ORDER BY id`)
+	require.NoError(t, err)
+
+	// BOOLEAN values scan into *bool, which is nil for NULL, and into sql.NullBool.
+	var ptrs []*bool
+	var nullBools []sql.NullBool
+	var lists []Composite[[]*bool]
+	for res.Next() {
+		var ptr *bool
+		var nullBool sql.NullBool
+		var list Composite[[]*bool]
+		require.NoError(t, res.Scan(&ptr, &nullBool, &list))
+		ptrs = append(ptrs, ptr)
+		nullBools = append(nullBools, nullBool)
+		lists = append(lists, list)
+	}
+	require.NoError(t, res.Err())
+	require.NoError(t, res.Close())
+
+	require.Equal(t, []*bool{&yes, &no, nil}, ptrs)
+	require.Equal(t, []sql.NullBool{{Bool: true, Valid: true}, {Bool: false, Valid: true}, {}}, nullBools)
+	require.Equal(t, []*bool{&yes, nil}, lists[0].Get())
+	require.Equal(t, []*bool{&no}, lists[1].Get())
+	require.Nil(t, lists[2].Get())
+
+	// Binding a *bool binds its value, or NULL.
+	var count int
+	require.NoError(t, db.QueryRow(`SELECT count(*) FROM test WHERE b = ?`, &no).Scan(&count))
+	require.Equal(t, 1, count)
+	require.NoError(t, db.QueryRow(`SELECT count(*) FROM test WHERE b IS NOT DISTINCT FROM ?`, null).Scan(&count))
+	require.Equal(t, 1, count)
+	cleanupAppender(t, c, con, a)
+}
+
 func TestAppenderUUID(t *testing.T) {
 	t.Parallel()
 	c, con, a := prepareAppender(t, `CREATE TABLE test (id UUID)`)
@@ -484,6 +570,32 @@ func TestAppenderUUID(t *testing.T) {
 	cleanupAppender(t, c, con, a)
 }
 
+func TestAppenderUUIDTypes(t *testing.T) {
+	t.Parallel()
+	c, con, a := prepareAppender(t, `CREATE TABLE test (i INTEGER, id UUID)`)
+
+	ids := []uuid.UUID{uuid.New(), uuid.New(), uuid.New()}
+	require.NoError(t, a.AppendRow(int32(0), ids[0]))
+	require.NoError(t, a.AppendRow(int32(1), [16]byte(ids[1])))
+	require.NoError(t, a.AppendRow(int32(2), ids[2].String()))
+	require.NoError(t, a.Flush())
+
+	// Verify results.
+	res, err := sql.OpenDB(c).QueryContext(context.Background(), `SELECT id::VARCHAR FROM test ORDER BY i`)
+	require.NoError(t, err)
+
+	i := 0
+	for res.Next() {
+		var r string
+		require.NoError(t, res.Scan(&r))
+		require.Equal(t, ids[i].String(), r)
+		i++
+	}
+	require.Equal(t, len(ids), i)
+	require.NoError(t, res.Close())
+	cleanupAppender(t, c, con, a)
+}
+
 func newAppenderHugeIntTest[T numericType](val T, c *Connector, a *Appender) func(t *testing.T) {
 	return func(t *testing.T) {
 		typeName := reflect.TypeOf(val).String()
@@ -556,20 +668,84 @@ func TestAppenderDate(t *testing.T) {
 	cleanupAppender(t, c, con, a)
 }
 
+func TestAppenderInterval(t *testing.T) {
+	t.Parallel()
+	c, con, a := prepareAppender(t, `CREATE TABLE test (id INTEGER, i INTERVAL)`)
+
+	intervals := []Interval{
+		{Days: 3, Months: 14, Micros: ((4*60+5)*60 + 6) * 1000000},
+		{Days: -3, Months: 1, Micros: -5},
+		{Days: 0, Months: -14, Micros: 42},
+		{Days: math.MaxInt32, Months: math.MaxInt32, Micros: math.MaxInt64},
+		{Days: math.MinInt32, Months: math.MinInt32, Micros: math.MinInt64},
+	}
+	for i, interval := range intervals {
+		require.NoError(t, a.AppendRow(int32(i), interval))
+	}
+	require.NoError(t, a.AppendRow(int32(len(intervals)), &intervals[0]))
+	require.NoError(t, a.Flush())
+
+	// Verify results.
+	rows, err := sql.OpenDB(c).QueryContext(context.Background(), `SELECT i FROM test ORDER BY id`)
+	require.NoError(t, err)
+	var res []Interval
+	for rows.Next() {
+		var interval Interval
+		require.NoError(t, rows.Scan(&interval))
+		res = append(res, interval)
+	}
+	require.NoError(t, rows.Err())
+	require.NoError(t, rows.Close())
+	require.Equal(t, append(intervals, intervals[0]), res)
+
+	// The appended intervals equal the intervals of DuckDB's interval literals.
+	var equal bool
+	row := sql.OpenDB(c).QueryRowContext(context.Background(),
+		`SELECT i = INTERVAL '1 year 2 months 3 days 04:05:06' FROM test WHERE id = 0`)
+	require.NoError(t, row.Scan(&equal))
+	require.True(t, equal)
+	cleanupAppender(t, c, con, a)
+}
+
 func TestAppenderTime(t *testing.T) {
 	t.Parallel()
 	c, con, a := prepareAppender(t, `CREATE TABLE test (time TIME)`)
 
-	ts := time.Date(1996, time.July, 23, 11, 42, 23, 123, time.UTC)
+	// A time.Time appends its clock, and a time.Duration the time since midnight.
+	ts := time.Date(1996, time.July, 23, 13, 45, 30, 123456789, time.UTC)
 	require.NoError(t, a.AppendRow(ts))
+	require.NoError(t, a.AppendRow(13*time.Hour+45*time.Minute+30*time.Second))
+	require.NoError(t, a.AppendRow(time.Date(2024, time.March, 1, 13, 45, 30, 0, time.FixedZone("UTC+2", 2*60*60))))
+
+	for _, d := range []time.Duration{-time.Microsecond, 24 * time.Hour} {
+		err := a.AppendRow(d)
+		require.Equal(t, ErrorTypeOutOfRange, GetErrorType(err))
+		require.ErrorContains(t, err, "out of range for TIME")
+	}
 	require.NoError(t, a.Flush())
 
 	// Verify results.
-	row := sql.OpenDB(c).QueryRowContext(context.Background(), `SELECT time FROM test`)
+	res, err := sql.OpenDB(c).QueryContext(context.Background(), `SELECT time, time::VARCHAR FROM test`)
+	require.NoError(t, err)
 
-	var res time.Time
-	require.NoError(t, row.Scan(&res))
-	require.Equal(t, ts.UnixMicro(), res.UnixMicro())
+	var times []time.Time
+	var strs []string
+	for res.Next() {
+		var ti time.Time
+		var str string
+		require.NoError(t, res.Scan(&ti, &str))
+		times = append(times, ti)
+		strs = append(strs, str)
+	}
+	require.NoError(t, res.Err())
+	require.NoError(t, res.Close())
+
+	require.Equal(t, []time.Time{
+		time.Date(1970, time.January, 1, 13, 45, 30, 123456000, time.UTC),
+		time.Date(1970, time.January, 1, 13, 45, 30, 0, time.UTC),
+		time.Date(1970, time.January, 1, 13, 45, 30, 0, time.UTC),
+	}, times)
+	require.Equal(t, []string{"13:45:30.123456", "13:45:30", "13:45:30"}, strs)
 	cleanupAppender(t, c, con, a)
 }
 
@@ -887,3 +1063,806 @@ func appendNestedData[T require.TestingT](t T, a *Appender, rowsToAppend []neste
 	}
 	require.NoError(t, a.Flush())
 }
+
+func TestAppenderFlushThreshold(t *testing.T) {
+	t.Parallel()
+	c, con, a := prepareAppender(t, `CREATE TABLE test (id INTEGER)`)
+	a.SetFlushThreshold(4)
+
+	countRows := func() int {
+		var count int
+		require.NoError(t, sql.OpenDB(c).QueryRow(`SELECT COUNT(*) FROM test`).Scan(&count))
+		return count
+	}
+
+	for i := 0; i < 10; i++ {
+		require.NoError(t, a.AppendRow(int32(i)))
+	}
+	require.Equal(t, 8, countRows())
+
+	require.NoError(t, a.Close())
+	require.Equal(t, 10, countRows())
+	require.NoError(t, con.Close())
+	require.NoError(t, c.Close())
+}
+
+func TestAppenderAppendFromChannel(t *testing.T) {
+	t.Parallel()
+	c, con, a := prepareAppender(t, `CREATE TABLE test (id BIGINT, name VARCHAR)`)
+
+	const rowCount = 100000
+	ch := make(chan []any, 64)
+	go func() {
+		defer close(ch)
+		for i := 0; i < rowCount; i++ {
+			ch <- []any{int64(i), fmt.Sprintf("row%d", i)}
+		}
+	}()
+	require.NoError(t, a.AppendFromChannel(context.Background(), ch))
+
+	var count, sum int64
+	row := sql.OpenDB(c).QueryRow(`SELECT COUNT(*), SUM(id) FROM test WHERE name = 'row' || id`)
+	require.NoError(t, row.Scan(&count, &sum))
+	require.Equal(t, int64(rowCount), count)
+	require.Equal(t, int64(rowCount*(rowCount-1)/2), sum)
+
+	cleanupAppender(t, c, con, a)
+}
+
+func TestAppenderAppendFromChannelCancel(t *testing.T) {
+	t.Parallel()
+	c, con, a := prepareAppender(t, `CREATE TABLE test (id BIGINT, name VARCHAR)`)
+
+	// Cancelling the context stops consuming the channel and flushes the appended rows.
+	ctx, cancel := context.WithCancel(context.Background())
+	ch := make(chan []any)
+	go func() {
+		for i := 0; i < 10; i++ {
+			ch <- []any{int64(i), "a"}
+		}
+		cancel()
+	}()
+	err := a.AppendFromChannel(ctx, ch)
+	require.ErrorIs(t, err, context.Canceled)
+
+	var count int64
+	require.NoError(t, sql.OpenDB(c).QueryRow(`SELECT COUNT(*) FROM test`).Scan(&count))
+	require.Equal(t, int64(10), count)
+
+	// The appender remains usable after a cancellation.
+	require.NoError(t, a.AppendRow(int64(10), "b"))
+	cleanupAppender(t, c, con, a)
+}
+
+func TestAppenderAppendFromChannelErr(t *testing.T) {
+	t.Parallel()
+	c, con, a := prepareAppender(t, `CREATE TABLE test (id BIGINT, name VARCHAR)`)
+
+	ch := make(chan []any, 3)
+	ch <- []any{int64(0), "a"}
+	ch <- []any{"not a number", "b"}
+	ch <- []any{int64(2), "c"}
+	close(ch)
+
+	err := a.AppendFromChannel(context.Background(), ch)
+	testError(t, err, errAppenderAppendRow.Error(), castErrMsg)
+
+	// The erroneous row stops consuming the channel, and invalidates the appender.
+	require.Len(t, ch, 1)
+	err = a.AppendRow(int64(3), "d")
+	testError(t, err, errAppenderAppendAfterFlushErr.Error())
+	err = a.AppendFromChannel(context.Background(), ch)
+	testError(t, err, errAppenderAppendAfterFlushErr.Error())
+
+	// Closing the appender writes the rows before the erroneous row.
+	require.NoError(t, a.Close())
+	var count int64
+	require.NoError(t, sql.OpenDB(c).QueryRow(`SELECT COUNT(*) FROM test`).Scan(&count))
+	require.Equal(t, int64(1), count)
+	require.NoError(t, con.Close())
+	require.NoError(t, c.Close())
+}
+
+func TestAppenderFlushErr(t *testing.T) {
+	t.Parallel()
+	c, con, a := prepareAppender(t, `CREATE TABLE test (id INTEGER PRIMARY KEY)`)
+
+	// A successful flush keeps the appender usable.
+	require.NoError(t, a.AppendRow(int32(1)))
+	require.NoError(t, a.Flush())
+	require.NoError(t, a.AppendRow(int32(2)))
+	require.NoError(t, a.Flush())
+
+	// A failed flush invalidates the appender.
+	require.NoError(t, a.AppendRow(int32(3)))
+	require.NoError(t, a.AppendRow(int32(1)))
+	err := a.Flush()
+	testError(t, err, errAppenderFlush.Error(), invalidatedAppenderMsg)
+	require.ErrorIs(t, err, ErrConstraintViolation)
+
+	err = a.AppendRow(int32(4))
+	testError(t, err, errAppenderAppendAfterFlushErr.Error())
+	require.ErrorIs(t, err, ErrConstraintViolation)
+	err = a.AppendStructs([]struct{ ID int32 }{{5}})
+	testError(t, err, errAppenderAppendAfterFlushErr.Error())
+	err = a.Flush()
+	testError(t, err, errAppenderFlush.Error())
+	require.ErrorIs(t, err, ErrConstraintViolation)
+	require.Error(t, a.Close())
+
+	// The table only contains the rows of the successful flushes.
+	var ids []any
+	require.NoError(t, sql.OpenDB(c).QueryRow(`SELECT list(id ORDER BY id) FROM test`).Scan(&ids))
+	require.Equal(t, []any{int32(1), int32(2)}, ids)
+	require.NoError(t, con.Close())
+	require.NoError(t, c.Close())
+}
+
+func TestAppenderLists(t *testing.T) {
+	t.Parallel()
+	c, con, a := prepareAppender(t, `CREATE TABLE test (id INTEGER, strings VARCHAR[], ints BIGINT[])`)
+
+	one := int64(1)
+	require.NoError(t, a.AppendRow(int32(0), []string{"a", "b", "c"}, []int64{1, 2, 3}))
+	require.NoError(t, a.AppendRow(int32(1), []string{}, []*int64{&one, nil}))
+	require.NoError(t, a.AppendRow(int32(2), nil, []any{nil, int64(42)}))
+	require.NoError(t, a.Flush())
+
+	res, err := sql.OpenDB(c).QueryContext(context.Background(), `SELECT strings, ints FROM test ORDER BY id`)
+	require.NoError(t, err)
+
+	expected := []struct {
+		strings any
+		ints    any
+	}{
+		{[]any{"a", "b", "c"}, []any{int64(1), int64(2), int64(3)}},
+		{[]any{}, []any{int64(1), nil}},
+		{nil, []any{nil, int64(42)}},
+	}
+
+	i := 0
+	for res.Next() {
+		var strs, ints any
+		require.NoError(t, res.Scan(&strs, &ints))
+		require.Equal(t, expected[i].strings, strs)
+		require.Equal(t, expected[i].ints, ints)
+		i++
+	}
+	require.Equal(t, len(expected), i)
+	require.NoError(t, res.Close())
+	cleanupAppender(t, c, con, a)
+}
+
+func TestAppenderStructs(t *testing.T) {
+	t.Parallel()
+	c, con, a := prepareAppender(t, `CREATE TABLE test (
+		id INTEGER,
+		person STRUCT(name VARCHAR, age INTEGER),
+		nested STRUCT(person STRUCT(name VARCHAR, age INTEGER), tag VARCHAR)
+	)`)
+
+	type person struct {
+		Name string `db:"name"`
+		Age  int32  `db:"age"`
+	}
+	type nested struct {
+		Person *person `db:"person"`
+		Tag    string  `db:"tag"`
+	}
+
+	require.NoError(t, a.AppendRow(int32(0),
+		map[string]any{"name": "Alice", "age": int32(42)},
+		nested{Person: &person{Name: "Bob", Age: 7}, Tag: "a"}))
+	require.NoError(t, a.AppendRow(int32(1), person{Name: "Carol", Age: 3}, nested{Person: nil, Tag: "b"}))
+	require.NoError(t, a.AppendRow(int32(2), nil, (*nested)(nil)))
+	require.NoError(t, a.Flush())
+
+	res, err := sql.OpenDB(c).QueryContext(context.Background(), `SELECT person, nested FROM test ORDER BY id`)
+	require.NoError(t, err)
+
+	expected := [][]any{
+		{
+			map[string]any{"name": "Alice", "age": int32(42)},
+			map[string]any{"person": map[string]any{"name": "Bob", "age": int32(7)}, "tag": "a"},
+		},
+		{
+			map[string]any{"name": "Carol", "age": int32(3)},
+			map[string]any{"person": nil, "tag": "b"},
+		},
+		{nil, nil},
+	}
+
+	i := 0
+	for res.Next() {
+		var p, n any
+		require.NoError(t, res.Scan(&p, &n))
+		require.Equal(t, expected[i], []any{p, n})
+		i++
+	}
+	require.Equal(t, len(expected), i)
+	require.NoError(t, res.Close())
+	cleanupAppender(t, c, con, a)
+}
+
+func TestAppenderWithSchema(t *testing.T) {
+	t.Parallel()
+	c, err := NewConnector("", nil)
+	require.NoError(t, err)
+
+	db := sql.OpenDB(c)
+	_, err = db.Exec(`ATTACH ':memory:' AS other`)
+	require.NoError(t, err)
+	_, err = db.Exec(`CREATE SCHEMA other.s`)
+	require.NoError(t, err)
+	_, err = db.Exec(`CREATE TABLE other.s.test (id INTEGER, name VARCHAR)`)
+	require.NoError(t, err)
+	_, err = db.Exec(`CREATE TABLE other.main.test (id INTEGER)`)
+	require.NoError(t, err)
+
+	con, err := c.Connect(context.Background())
+	require.NoError(t, err)
+
+	a, err := NewAppenderWithSchema(con, "other", "s", "test")
+	require.NoError(t, err)
+	require.NoError(t, a.AppendRow(int32(1), "hello"))
+	require.NoError(t, a.Flush())
+	require.NoError(t, a.AppendRow(int32(2), "world"))
+	require.NoError(t, a.Close())
+
+	a, err = NewAppenderWithSchema(con, "other", "", "test")
+	require.NoError(t, err)
+	require.NoError(t, a.AppendRow(int32(3)))
+	require.NoError(t, a.Close())
+
+	// The appender does not change the default catalog of the connection.
+	res, err := con.(driver.QueryerContext).QueryContext(context.Background(), `SELECT current_database()`, nil)
+	require.NoError(t, err)
+	values := make([]driver.Value, 1)
+	require.NoError(t, res.Next(values))
+	require.Equal(t, "memory", values[0])
+	require.NoError(t, res.Close())
+
+	var count int
+	var sum int32
+	require.NoError(t, db.QueryRow(`SELECT count(*), sum(id) FROM other.s.test`).Scan(&count, &sum))
+	require.Equal(t, 2, count)
+	require.Equal(t, int32(3), sum)
+
+	var id int32
+	require.NoError(t, db.QueryRow(`SELECT id FROM other.main.test`).Scan(&id))
+	require.Equal(t, int32(3), id)
+
+	require.NoError(t, con.Close())
+	require.NoError(t, db.Close())
+	require.NoError(t, c.Close())
+}
+
+func TestAppenderWithCatalogSearchPath(t *testing.T) {
+	t.Parallel()
+	path := filepath.Join(t.TempDir(), "search_path.db")
+
+	c, err := NewConnector(path, nil)
+	require.NoError(t, err)
+	db := sql.OpenDB(c)
+	_, err = db.Exec(`CREATE SCHEMA a; CREATE SCHEMA b`)
+	require.NoError(t, err)
+	require.NoError(t, db.Close())
+
+	var queries []string
+	logger := func(query string, args []driver.NamedValue, d time.Duration, err error) {
+		queries = append(queries, query)
+	}
+	c, err = NewConnector(path, nil, WithSearchPath("a,b"), WithQueryLogger(logger))
+	require.NoError(t, err)
+	con, err := c.Connect(context.Background())
+	require.NoError(t, err)
+
+	execer := con.(driver.ExecerContext)
+	_, err = execer.ExecContext(context.Background(), `ATTACH ':memory:' AS other`, nil)
+	require.NoError(t, err)
+	_, err = execer.ExecContext(context.Background(), `CREATE TABLE other.test (id INTEGER)`, nil)
+	require.NoError(t, err)
+
+	a, err := NewAppenderWithSchema(con, "other", "", "test")
+	require.NoError(t, err)
+	require.NoError(t, a.AppendRow(int32(1)))
+	require.NoError(t, a.Flush())
+	require.NoError(t, a.Close())
+
+	// The appender restores the search_path of the connection, and it does not log its internal statements.
+	res, err := con.(driver.QueryerContext).QueryContext(context.Background(),
+		`SELECT current_setting('search_path'), (SELECT count(*) FROM other.test)`, nil)
+	require.NoError(t, err)
+	values := make([]driver.Value, 2)
+	require.NoError(t, res.Next(values))
+	require.Equal(t, "a,b", values[0])
+	require.Equal(t, int64(1), values[1])
+	require.NoError(t, res.Close())
+
+	require.Equal(t, []string{
+		`ATTACH ':memory:' AS other`,
+		`CREATE TABLE other.test (id INTEGER)`,
+		`SELECT current_setting('search_path'), (SELECT count(*) FROM other.test)`,
+	}, queries)
+
+	require.NoError(t, con.Close())
+	require.NoError(t, c.Close())
+}
+
+func TestAppenderWithCurrentSchema(t *testing.T) {
+	t.Parallel()
+	path := filepath.Join(t.TempDir(), "current_schema.db")
+
+	c, err := NewConnector(path, nil)
+	require.NoError(t, err)
+	db := sql.OpenDB(c)
+	_, err = db.Exec(`CREATE SCHEMA s;
+		CREATE TABLE s.test (id INTEGER, name VARCHAR DEFAULT 'unknown');
+		CREATE TABLE main.test (other BOOLEAN)`)
+	require.NoError(t, err)
+	require.NoError(t, db.Close())
+
+	// An empty schema resolves to the current schema of the connection, and not to the main schema.
+	c, err = NewConnector(path, nil, WithSearchPath("s"))
+	require.NoError(t, err)
+	con, err := c.Connect(context.Background())
+	require.NoError(t, err)
+
+	a, err := NewAppenderFromConn(con, "", "test")
+	require.NoError(t, err)
+	require.NoError(t, a.AppendRow(int32(1), Default))
+	require.NoError(t, a.AppendStructs([]struct {
+		ID   int32
+		Name string
+	}{{ID: 2, Name: "bob"}}))
+	require.NoError(t, a.Close())
+	require.NoError(t, con.Close())
+
+	db = sql.OpenDB(c)
+	rows, err := db.Query(`SELECT id, name FROM s.test ORDER BY id`)
+	require.NoError(t, err)
+	var names []string
+	for rows.Next() {
+		var id int32
+		var name string
+		require.NoError(t, rows.Scan(&id, &name))
+		names = append(names, name)
+	}
+	require.NoError(t, rows.Err())
+	require.NoError(t, rows.Close())
+	require.Equal(t, []string{"unknown", "bob"}, names)
+	require.NoError(t, db.Close())
+}
+
+func TestAppenderDiscardFailedRow(t *testing.T) {
+	t.Parallel()
+	c, con, a := prepareAppender(t, `CREATE TABLE test (a INTEGER, b STRUCT(v INTEGER), c INTEGER)`)
+
+	// The failed row sets NULL values before failing on the last column.
+	err := a.AppendRow(nil, nil, "not an integer")
+	require.ErrorContains(t, err, columnIndexErrMsg+": 2")
+
+	require.NoError(t, a.AppendRow(int32(1), map[string]any{"v": int32(2)}, int32(3)))
+	require.NoError(t, a.Flush())
+
+	var x, y, z any
+	require.NoError(t, sql.OpenDB(c).QueryRow(`SELECT a, b, c FROM test`).Scan(&x, &y, &z))
+	require.Equal(t, int32(1), x)
+	require.Equal(t, map[string]any{"v": int32(2)}, y)
+	require.Equal(t, int32(3), z)
+	cleanupAppender(t, c, con, a)
+}
+
+func TestAppenderListOfStructs(t *testing.T) {
+	t.Parallel()
+	c, con, a := prepareAppender(t, `CREATE TABLE test (id INTEGER, events STRUCT(id INTEGER, name VARCHAR)[])`)
+
+	type event struct {
+		ID   int32   `db:"id"`
+		Name *string `db:"name"`
+	}
+	name := "click"
+
+	require.NoError(t, a.AppendRow(int32(0), []event{{ID: 1, Name: &name}, {ID: 2, Name: nil}}))
+	require.NoError(t, a.AppendRow(int32(1), []*event{{ID: 3, Name: &name}, nil}))
+	require.NoError(t, a.AppendRow(int32(2), []event{}))
+	require.NoError(t, a.AppendRow(int32(3), nil))
+	require.NoError(t, a.Flush())
+
+	res, err := sql.OpenDB(c).Query(`SELECT events FROM test ORDER BY id`)
+	require.NoError(t, err)
+
+	expected := []any{
+		[]any{
+			map[string]any{"id": int32(1), "name": "click"},
+			map[string]any{"id": int32(2), "name": nil},
+		},
+		[]any{
+			map[string]any{"id": int32(3), "name": "click"},
+			nil,
+		},
+		[]any{},
+		nil,
+	}
+
+	i := 0
+	for res.Next() {
+		var events any
+		require.NoError(t, res.Scan(&events))
+		require.Equal(t, expected[i], events)
+		i++
+	}
+	require.Equal(t, len(expected), i)
+	require.NoError(t, res.Close())
+	cleanupAppender(t, c, con, a)
+}
+
+func TestAppenderBitstring(t *testing.T) {
+	t.Parallel()
+	c, con, a := prepareAppender(t, `CREATE TABLE test (id INTEGER, b BIT)`)
+
+	b, err := NewBitstring("101")
+	require.NoError(t, err)
+	require.NoError(t, a.AppendRow(int32(0), b))
+	require.NoError(t, a.AppendRow(int32(1), "0000000011"))
+	require.NoError(t, a.AppendRow(int32(2), nil))
+	require.ErrorIs(t, a.AppendRow(int32(3), "12"), errInvalidBitstring)
+	require.NoError(t, a.Flush())
+
+	res, err := sql.OpenDB(c).Query(`SELECT b::VARCHAR, bit_count(b) FROM test ORDER BY id`)
+	require.NoError(t, err)
+
+	var strs []*string
+	var counts []*int64
+	for res.Next() {
+		var s *string
+		var count *int64
+		require.NoError(t, res.Scan(&s, &count))
+		strs = append(strs, s)
+		counts = append(counts, count)
+	}
+	require.NoError(t, res.Err())
+	require.NoError(t, res.Close())
+
+	require.Len(t, strs, 3)
+	require.Equal(t, "101", *strs[0])
+	require.Equal(t, "0000000011", *strs[1])
+	require.Nil(t, strs[2])
+	// The padding bits do not count as set bits.
+	require.Equal(t, int64(2), *counts[0])
+	require.Equal(t, int64(2), *counts[1])
+	cleanupAppender(t, c, con, a)
+}
+
+func TestAppenderDefault(t *testing.T) {
+	t.Parallel()
+	c, con, a := prepareAppender(t, `CREATE SEQUENCE seq;
+		CREATE TABLE test (
+			id INTEGER DEFAULT nextval('seq'),
+			ts TIMESTAMP DEFAULT now(),
+			name VARCHAR,
+			tag VARCHAR DEFAULT 'none'
+		)`)
+
+	before := time.Now().UTC().Add(-time.Minute)
+	require.NoError(t, a.AppendRow(Default, Default, "a", Default))
+	require.NoError(t, a.AppendRow(int32(-1), Default, "b", "tag"))
+
+	// Columns without a DEFAULT value must be provided, and the failed row is discarded.
+	err := a.AppendRow(Default, Default, Default, Default)
+	require.ErrorIs(t, err, errAppenderNoDefault)
+	require.ErrorContains(t, err, columnIndexErrMsg+": 2")
+
+	// Each Default value evaluates the DEFAULT expression, also across data chunks.
+	for i := 0; i < GetDataChunkCapacity(); i++ {
+		require.NoError(t, a.AppendRow(Default, Default, nil, nil))
+	}
+	require.NoError(t, a.Flush())
+
+	db := sql.OpenDB(c)
+	var id int32
+	var ts time.Time
+	var tag string
+	require.NoError(t, db.QueryRow(`SELECT id, ts, tag FROM test WHERE name = 'a'`).Scan(&id, &ts, &tag))
+	require.Equal(t, int32(1), id)
+	require.True(t, ts.After(before))
+	require.Equal(t, "none", tag)
+
+	require.NoError(t, db.QueryRow(`SELECT id, tag FROM test WHERE name = 'b'`).Scan(&id, &tag))
+	require.Equal(t, int32(-1), id)
+	require.Equal(t, "tag", tag)
+
+	var count, distinctIDs int
+	require.NoError(t, db.QueryRow(`SELECT count(*), count(DISTINCT id) FROM test WHERE ts IS NOT NULL`).Scan(&count, &distinctIDs))
+	require.Equal(t, GetDataChunkCapacity()+2, count)
+	require.Equal(t, count, distinctIDs)
+	cleanupAppender(t, c, con, a)
+}
+
+func TestAppenderSequenceDefault(t *testing.T) {
+	t.Parallel()
+	c, con, a := prepareAppender(t, `CREATE SEQUENCE seq START 100;
+		CREATE TABLE test (id BIGINT DEFAULT nextval('seq'), name VARCHAR)`)
+
+	type row struct {
+		Name string
+	}
+
+	// AppendRow skips the sequence column with Default, and AppendStructs with structs omitting it.
+	rowCount := 0
+	for i := 0; i < GetDataChunkCapacity()+10; i++ {
+		require.NoError(t, a.AppendRow(Default, fmt.Sprintf("row%d", rowCount)))
+		rowCount++
+	}
+	require.NoError(t, a.Flush())
+	var rows []row
+	for i := 0; i < 10; i++ {
+		rows = append(rows, row{Name: fmt.Sprintf("row%d", rowCount)})
+		rowCount++
+	}
+	require.NoError(t, a.AppendStructs(rows))
+	require.NoError(t, a.AppendRow(Default, fmt.Sprintf("row%d", rowCount)))
+	rowCount++
+
+	// A struct field without a column fails.
+	type wrongRow struct {
+		Other string
+	}
+	err := a.AppendStructs([]wrongRow{{Other: "a"}})
+	require.ErrorContains(t, err, structFieldErrMsg)
+	require.NoError(t, a.Flush())
+
+	// The IDs increase monotonically in the order of appending, without gaps.
+	res, err := sql.OpenDB(c).Query(`SELECT id, name FROM test ORDER BY rowid`)
+	require.NoError(t, err)
+	i := 0
+	for res.Next() {
+		var id int64
+		var name string
+		require.NoError(t, res.Scan(&id, &name))
+		require.Equal(t, int64(100+i), id)
+		require.Equal(t, fmt.Sprintf("row%d", i), name)
+		i++
+	}
+	require.NoError(t, res.Err())
+	require.NoError(t, res.Close())
+	require.Equal(t, rowCount, i)
+	cleanupAppender(t, c, con, a)
+}
+
+func TestAppenderGenerated(t *testing.T) {
+	t.Parallel()
+	c, con, a := prepareAppender(t, `CREATE TABLE test (
+			price DOUBLE,
+			qty INTEGER DEFAULT 1,
+			total DOUBLE GENERATED ALWAYS AS (price * qty),
+			name VARCHAR
+		)`)
+
+	// The appender expects only the base columns, and DuckDB computes the GENERATED column.
+	require.NoError(t, a.AppendRow(2.5, int32(4), "a"))
+	require.NoError(t, a.AppendRow(1.5, Default, "b"))
+
+	type row struct {
+		Price float64
+		Qty   int32
+		Name  string
+	}
+	require.NoError(t, a.AppendStructs([]row{{Price: 3, Qty: 2, Name: "c"}}))
+
+	// Values for the GENERATED column fail with a clear error.
+	err := a.AppendRow(2.5, int32(4), 10.0, "d")
+	require.ErrorIs(t, err, errAppenderGenerated)
+	require.ErrorContains(t, err, columnCountErrMsg)
+	require.ErrorContains(t, err, "total")
+
+	type rowWithTotal struct {
+		Price float64
+		Qty   int32
+		Total float64
+		Name  string
+	}
+	err = a.AppendStructs([]rowWithTotal{{Price: 1, Qty: 1, Total: 1, Name: "e"}})
+	require.ErrorIs(t, err, errAppenderGenerated)
+	require.ErrorContains(t, err, "total")
+	require.NoError(t, a.Flush())
+
+	// Only the binder error of a GENERATED column marks a column as GENERATED, other probe errors fail.
+	isGenerated, err := a.isGeneratedColumn("total")
+	require.NoError(t, err)
+	require.True(t, isGenerated)
+	isGenerated, err = a.isGeneratedColumn("qty")
+	require.NoError(t, err)
+	require.False(t, isGenerated)
+	_, err = a.isGeneratedColumn("does_not_exist")
+	require.Equal(t, ErrorTypeBinder, GetErrorType(err))
+
+	db := sql.OpenDB(c)
+	res, err := db.Query(`SELECT name, total FROM test ORDER BY name`)
+	require.NoError(t, err)
+	var names []string
+	var totals []float64
+	for res.Next() {
+		var name string
+		var total float64
+		require.NoError(t, res.Scan(&name, &total))
+		names = append(names, name)
+		totals = append(totals, total)
+	}
+	require.NoError(t, res.Err())
+	require.NoError(t, res.Close())
+	require.Equal(t, []string{"a", "b", "c"}, names)
+	require.Equal(t, []float64{10, 1.5, 6}, totals)
+	cleanupAppender(t, c, con, a)
+}
+
+func TestCopyRows(t *testing.T) {
+	t.Parallel()
+	c, con, a := prepareAppender(t, `CREATE TABLE test (id INTEGER, name VARCHAR, score DOUBLE, tags VARCHAR[])`)
+
+	db := sql.OpenDB(c)
+	_, err := db.Exec(`CREATE TABLE source AS SELECT * FROM (VALUES
+		(1::BIGINT, 'a', 1.5::FLOAT, ['x', 'y']),
+		(2::BIGINT, NULL, NULL, NULL),
+		(3::BIGINT, 'c', 3::FLOAT, [])
+	) t(id, name, score, tags)`)
+	require.NoError(t, err)
+
+	// A mismatching column count fails before appending any rows.
+	res, err := db.Query(`SELECT id, name FROM source`)
+	require.NoError(t, err)
+	err = CopyRows(a, res)
+	require.ErrorIs(t, err, errAppenderAppendRow)
+	require.ErrorContains(t, err, columnCountErrMsg)
+	require.NoError(t, res.Close())
+
+	// CopyRows converts the BIGINT and FLOAT columns to the INTEGER and DOUBLE columns.
+	res, err = db.Query(`SELECT id, name, score, tags FROM source WHERE id > 1 OR name = 'a' ORDER BY id`)
+	require.NoError(t, err)
+	require.NoError(t, CopyRows(a, res))
+	require.NoError(t, res.Close())
+	require.NoError(t, a.Flush())
+
+	res, err = db.Query(`SELECT id, name, score, tags FROM test ORDER BY id`)
+	require.NoError(t, err)
+	type row struct {
+		id    int32
+		name  *string
+		score *float64
+		tags  any
+	}
+	var rows []row
+	for res.Next() {
+		var r row
+		require.NoError(t, res.Scan(&r.id, &r.name, &r.score, &r.tags))
+		rows = append(rows, r)
+	}
+	require.NoError(t, res.Err())
+	require.NoError(t, res.Close())
+
+	a1, c3 := "a", "c"
+	s1, s3 := 1.5, 3.0
+	require.Equal(t, []row{
+		{id: 1, name: &a1, score: &s1, tags: []any{"x", "y"}},
+		{id: 2},
+		{id: 3, name: &c3, score: &s3, tags: []any{}},
+	}, rows)
+
+	require.NoError(t, db.Close())
+	cleanupAppender(t, c, con, a)
+}
+
+func TestAppenderColumnTypes(t *testing.T) {
+	t.Parallel()
+	c, con, a := prepareAppender(t, `CREATE TABLE test (
+			id INTEGER,
+			price DECIMAL(18, 4),
+			name VARCHAR,
+			tags VARCHAR[],
+			props STRUCT(a INTEGER, b MAP(VARCHAR, DOUBLE)),
+			total DOUBLE GENERATED ALWAYS AS (price * 2),
+			created TIMESTAMP
+		)`)
+
+	// The appender skips the GENERATED column.
+	require.Equal(t, 6, a.ColumnCount())
+	require.Equal(t, []string{
+		"INTEGER",
+		"DECIMAL(18,4)",
+		"VARCHAR",
+		"VARCHAR[]",
+		`STRUCT("a" INTEGER, "b" MAP(VARCHAR, DOUBLE))`,
+		"TIMESTAMP",
+	}, a.ColumnTypes())
+
+	// AppendRow expects exactly ColumnCount values.
+	err := a.AppendRow(int32(1))
+	require.ErrorContains(t, err, columnCountError(1, a.ColumnCount()).Error())
+	cleanupAppender(t, c, con, a)
+}
+
+func TestAppenderNull(t *testing.T) {
+	t.Parallel()
+	c, con, a := prepareAppender(t, `CREATE TABLE test (
+			id INTEGER,
+			b BOOLEAN, i8 TINYINT, i16 SMALLINT, i32 INTEGER, i64 BIGINT,
+			u8 UTINYINT, u16 USMALLINT, u32 UINTEGER, u64 UBIGINT, f FLOAT, d DOUBLE,
+			ts TIMESTAMP, ts_s TIMESTAMP_S, ts_ms TIMESTAMP_MS, ts_ns TIMESTAMP_NS, tz TIMESTAMPTZ,
+			date DATE, time TIME, iv INTERVAL, hi HUGEINT, uhi UHUGEINT, s VARCHAR, blob BLOB,
+			dec DECIMAL(9, 2), enum ENUM('a', 'b'), uuid UUID, bit BIT,
+			list INTEGER[], st STRUCT(a INTEGER), m MAP(VARCHAR, INTEGER), u UNION(num INTEGER, str VARCHAR)
+		)`)
+
+	// The untyped nil appends NULL for any column type.
+	untyped := make([]driver.Value, a.ColumnCount())
+	untyped[0] = int32(0)
+	require.NoError(t, a.AppendRow(untyped...))
+
+	// Typed nil values also append NULL.
+	require.NoError(t, a.AppendRow(int32(1),
+		(*bool)(nil), (*int8)(nil), (*int16)(nil), (*int32)(nil), (*int64)(nil),
+		(*uint8)(nil), (*uint16)(nil), (*uint32)(nil), (*uint64)(nil), (*float32)(nil), (*float64)(nil),
+		(*time.Time)(nil), (*time.Time)(nil), (*time.Time)(nil), (*time.Time)(nil), (*time.Time)(nil),
+		(*time.Time)(nil), (*time.Time)(nil), (*Interval)(nil), (*big.Int)(nil), (*big.Int)(nil), (*string)(nil), []byte(nil),
+		(*Decimal)(nil), (*string)(nil), (*UUID)(nil), (*Bitstring)(nil),
+		[]int32(nil), (*simpleStruct)(nil), Map(nil), (*Union)(nil),
+	))
+	require.NoError(t, a.Flush())
+
+	db := sql.OpenDB(c)
+	res, err := db.Query(`SELECT * EXCLUDE (id) FROM test ORDER BY id`)
+	require.NoError(t, err)
+	columns, err := res.Columns()
+	require.NoError(t, err)
+	rows := 0
+	for res.Next() {
+		values := make([]any, len(columns))
+		dest := make([]any, len(columns))
+		for i := range values {
+			dest[i] = &values[i]
+		}
+		require.NoError(t, res.Scan(dest...))
+		for i, v := range values {
+			require.Nil(t, v, columns[i])
+		}
+		rows++
+	}
+	require.NoError(t, res.Err())
+	require.NoError(t, res.Close())
+	require.Equal(t, 2, rows)
+	require.NoError(t, db.Close())
+	cleanupAppender(t, c, con, a)
+
+	// Nested values can contain NULL values, and pointers append their values.
+	c, con, a = prepareAppender(t, `CREATE TABLE test (
+			i INTEGER, list INTEGER[], st STRUCT(a INTEGER), m MAP(VARCHAR, INTEGER), u UNION(num INTEGER, str VARCHAR)
+		)`)
+	v := int32(42)
+	require.NoError(t, a.AppendRow(&v, []any{nil, (*int32)(nil), &v}, map[string]any{"a": (*int32)(nil)},
+		Map{"k": (*int32)(nil)}, Union{Tag: "num", Value: (*int32)(nil)}))
+	require.NoError(t, a.Flush())
+
+	db = sql.OpenDB(c)
+	var i int32
+	var list []any
+	var st map[string]any
+	var m Map
+	var u Union
+	require.NoError(t, db.QueryRow(`SELECT * FROM test`).Scan(&i, &list, &st, &m, &u))
+	require.Equal(t, v, i)
+	require.Equal(t, []any{nil, nil, v}, list)
+	require.Equal(t, map[string]any{"a": nil}, st)
+	require.Equal(t, Map{"k": nil}, m)
+	require.Equal(t, Union{Tag: "num"}, u)
+	require.NoError(t, db.Close())
+	cleanupAppender(t, c, con, a)
+
+	// A NULL in a NOT NULL column fails with a constraint error when flushing.
+	c, con, a = prepareAppender(t, `CREATE TABLE test (id INTEGER NOT NULL)`)
+	require.NoError(t, a.AppendRow((*int32)(nil)))
+	err = a.Flush()
+	require.ErrorIs(t, err, ErrConstraintViolation)
+	require.Error(t, a.Close())
+	require.NoError(t, con.Close())
+	require.NoError(t, c.Close())
+}
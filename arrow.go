@@ -64,6 +64,7 @@ import (
 	"database/sql/driver"
 	"errors"
 	"fmt"
+	"sync/atomic"
 	"unsafe"
 
 	"github.com/apache/arrow/go/v17/arrow"
@@ -93,6 +94,8 @@ func NewArrowFromConn(driverConn driver.Conn) (*Arrow, error) {
 
 // QueryContext prepares statements, executes them, returns Apache Arrow array.RecordReader as a result of the last
 // executed statement. Arguments are bound to the last statement.
+// The reader streams the result in record batches of at most GetDataChunkCapacity() rows.
+// You must release the reader to free the underlying DuckDB result.
 func (a *Arrow) QueryContext(ctx context.Context, query string, args ...any) (array.RecordReader, error) {
 	if a.c.closed {
 		return nil, errClosedCon
@@ -123,48 +126,98 @@ func (a *Arrow) QueryContext(ctx context.Context, query string, args ...any) (ar
 	if err != nil {
 		return nil, err
 	}
-	defer stmt.Close()
 
 	res, err := a.execute(stmt, a.anyArgsToNamedArgs(args))
 	if err != nil {
+		stmt.Close()
 		return nil, err
 	}
-	defer C.duckdb_destroy_arrow(res)
 
 	sc, err := a.queryArrowSchema(res)
 	if err != nil {
+		C.duckdb_destroy_arrow(res)
+		stmt.Close()
 		return nil, err
 	}
 
-	var recs []arrow.Record
-	defer func() {
-		for _, r := range recs {
-			r.Release()
-		}
-	}()
+	return &arrowRecordReader{
+		refCount: 1,
+		ctx:      ctx,
+		arrow:    a,
+		stmt:     stmt,
+		res:      res,
+		schema:   sc,
+		rowCount: uint64(C.duckdb_arrow_row_count(*res)),
+	}, nil
+}
 
-	rowCount := uint64(C.duckdb_arrow_row_count(*res))
+// arrowRecordReader streams the arrow.Record batches of a DuckDB Arrow result.
+// Each batch holds at most GetDataChunkCapacity() rows.
+// Releasing the reader frees the underlying DuckDB result.
+type arrowRecordReader struct {
+	refCount int64
+	ctx      context.Context
+	arrow    *Arrow
+	// stmt is the statement of which we are streaming the result.
+	stmt *Stmt
+	// res is the Arrow result of stmt.
+	res    *C.duckdb_arrow
+	schema *arrow.Schema
+	// rowCount is the total number of rows in the result.
+	rowCount uint64
+	// retrievedRows is the number of rows that we already streamed.
+	retrievedRows uint64
+	// rec is the current record batch.
+	rec arrow.Record
+	err error
+}
 
-	var retrievedRows uint64
+func (r *arrowRecordReader) Retain() {
+	atomic.AddInt64(&r.refCount, 1)
+}
 
-	for retrievedRows < rowCount {
-		select {
-		case <-ctx.Done():
-			return nil, ctx.Err()
-		default:
-		}
+func (r *arrowRecordReader) Release() {
+	if atomic.AddInt64(&r.refCount, -1) != 0 {
+		return
+	}
+	if r.rec != nil {
+		r.rec.Release()
+		r.rec = nil
+	}
+	C.duckdb_destroy_arrow(r.res)
+	r.stmt.Close()
+}
 
-		rec, err := a.queryArrowArray(res, sc)
-		if err != nil {
-			return nil, err
-		}
+func (r *arrowRecordReader) Schema() *arrow.Schema {
+	return r.schema
+}
 
-		recs = append(recs, rec)
+func (r *arrowRecordReader) Next() bool {
+	if r.rec != nil {
+		r.rec.Release()
+		r.rec = nil
+	}
+	if r.err != nil || r.retrievedRows >= r.rowCount {
+		return false
+	}
+	if r.err = r.ctx.Err(); r.err != nil {
+		return false
+	}
 
-		retrievedRows += uint64(rec.NumRows())
+	r.rec, r.err = r.arrow.queryArrowArray(r.res, r.schema)
+	if r.err != nil {
+		return false
 	}
+	r.retrievedRows += uint64(r.rec.NumRows())
+	return true
+}
+
+func (r *arrowRecordReader) Record() arrow.Record {
+	return r.rec
+}
 
-	return array.NewRecordReader(sc, recs)
+func (r *arrowRecordReader) Err() error {
+	return r.err
 }
 
 // queryArrowSchema fetches the internal arrow schema from the arrow result.
@@ -205,7 +258,7 @@ func (a *Arrow) queryArrowArray(res *C.duckdb_arrow, sc *arrow.Schema) (arrow.Re
 		*res,
 		(*C.duckdb_arrow_array)(unsafe.Pointer(&arr)),
 	); state == C.DuckDBError {
-		return nil, errors.New("duckdb_query_arrow_array")
+		return nil, getDuckDBError(C.GoString(C.duckdb_query_arrow_error(*res)))
 	}
 
 	rec, err := cdata.ImportCRecordBatchWithSchema((*cdata.CArrowArray)(arr), sc)
@@ -216,7 +269,7 @@ func (a *Arrow) queryArrowArray(res *C.duckdb_arrow, sc *arrow.Schema) (arrow.Re
 	return rec, nil
 }
 
-func (a *Arrow) execute(s *stmt, args []driver.NamedValue) (*C.duckdb_arrow, error) {
+func (a *Arrow) execute(s *Stmt, args []driver.NamedValue) (*C.duckdb_arrow, error) {
 	if s.closed {
 		return nil, errClosedCon
 	}
@@ -227,9 +280,9 @@ func (a *Arrow) execute(s *stmt, args []driver.NamedValue) (*C.duckdb_arrow, err
 
 	var res C.duckdb_arrow
 	if state := C.duckdb_execute_prepared_arrow(*s.stmt, &res); state == C.DuckDBError {
-		dbErr := C.GoString(C.duckdb_query_arrow_error(res))
+		dbErr := getDuckDBError(C.GoString(C.duckdb_query_arrow_error(res)))
 		C.duckdb_destroy_arrow(&res)
-		return nil, fmt.Errorf("duckdb_execute_prepared_arrow: %v", dbErr)
+		return nil, dbErr
 	}
 
 	return &res, nil
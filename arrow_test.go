@@ -77,6 +77,38 @@ func TestArrow(t *testing.T) {
 		require.NoError(t, rdr.Err())
 	})
 
+	t.Run("stream record batches", func(t *testing.T) {
+		c, err := NewConnector("", nil)
+		require.NoError(t, err)
+		defer c.Close()
+
+		conn, err := c.Connect(context.Background())
+		require.NoError(t, err)
+		defer conn.Close()
+
+		ar, err := NewArrowFromConn(conn)
+		require.NoError(t, err)
+
+		rdr, err := ar.QueryContext(context.Background(), "SELECT range AS i, range::VARCHAR AS s FROM range(10000)")
+		require.NoError(t, err)
+		defer rdr.Release()
+
+		require.Equal(t, 2, len(rdr.Schema().Fields()))
+		require.Equal(t, "i", rdr.Schema().Field(0).Name)
+		require.Equal(t, "s", rdr.Schema().Field(1).Name)
+
+		var batches, totalRows int64
+		for rdr.Next() {
+			rec := rdr.Record()
+			require.LessOrEqual(t, rec.NumRows(), int64(GetDataChunkCapacity()))
+			batches++
+			totalRows += rec.NumRows()
+		}
+		require.NoError(t, rdr.Err())
+		require.Equal(t, int64(10000), totalRows)
+		require.Greater(t, batches, int64(1))
+	})
+
 	t.Run("query table and filter results", func(t *testing.T) {
 		err = conn.Raw(func(driverConn any) error {
 			conn, ok := driverConn.(driver.Conn)
@@ -114,6 +146,7 @@ func TestArrow(t *testing.T) {
 
 			_, err = ar.QueryContext(context.Background(), "SELECT bar")
 			require.Error(t, err)
+			require.Equal(t, ErrorTypeBinder, GetErrorType(err))
 			return nil
 		})
 		require.NoError(t, err)
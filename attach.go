@@ -0,0 +1,47 @@
+package duckdb
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+)
+
+// Attach attaches the database file at path to the database of the connection, so that queries can refer to
+// its tables via the alias, e.g., alias.my_table. The path ':memory:' attaches a new in-memory database.
+// An empty alias defaults to the file name without its extension. A failure to attach a path or an alias
+// that is already attached is an ErrorTypeCatalog error.
+func Attach(c *sql.Conn, path string, alias string, readOnly bool) error {
+	query := "ATTACH " + quoteString(path)
+	if alias != "" {
+		query += " AS " + quoteIdentifier(alias)
+	}
+	if readOnly {
+		query += " (READ_ONLY)"
+	}
+
+	if _, err := c.ExecContext(context.Background(), query); err != nil {
+		return getError(errAttach, catalogError(err))
+	}
+	return nil
+}
+
+// Detach detaches the database with the given alias. Detaching an unknown alias is an ErrorTypeCatalog error.
+func Detach(c *sql.Conn, alias string) error {
+	if alias == "" {
+		return getError(errDetach, errEmptyName)
+	}
+	if _, err := c.ExecContext(context.Background(), "DETACH "+quoteIdentifier(alias)); err != nil {
+		return getError(errDetach, catalogError(err))
+	}
+	return nil
+}
+
+// catalogError classifies binder errors as catalog errors, as DuckDB binds ATTACH and DETACH statements
+// against the attached databases.
+func catalogError(err error) error {
+	var dbErr *Error
+	if errors.As(err, &dbErr) && dbErr.Type == ErrorTypeBinder {
+		return &Error{Type: ErrorTypeCatalog, Msg: dbErr.Msg}
+	}
+	return err
+}
@@ -0,0 +1,74 @@
+package duckdb
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestAttach(t *testing.T) {
+	t.Parallel()
+	db := openDB(t)
+	defer db.Close()
+
+	con, err := db.Conn(context.Background())
+	require.NoError(t, err)
+	defer con.Close()
+
+	// Create a table in the attached database via its alias, and query across both databases.
+	require.NoError(t, Attach(con, ":memory:", "other", false))
+	_, err = con.ExecContext(context.Background(), `CREATE TABLE other.t AS SELECT 42 AS i`)
+	require.NoError(t, err)
+	_, err = con.ExecContext(context.Background(), `CREATE TABLE t AS SELECT 1 AS i`)
+	require.NoError(t, err)
+
+	var sum int
+	require.NoError(t, con.QueryRowContext(context.Background(), `SELECT t.i + o.i FROM t, other.t o`).Scan(&sum))
+	require.Equal(t, 43, sum)
+
+	// Attaching an alias twice is a catalog error.
+	err = Attach(con, ":memory:", "other", false)
+	require.ErrorIs(t, err, errAttach)
+	require.Equal(t, ErrorTypeCatalog, GetErrorType(err))
+
+	require.NoError(t, Detach(con, "other"))
+	_, err = con.ExecContext(context.Background(), `SELECT * FROM other.t`)
+	require.Equal(t, ErrorTypeCatalog, GetErrorType(err))
+
+	err = Detach(con, "other")
+	require.ErrorIs(t, err, errDetach)
+	require.Equal(t, ErrorTypeCatalog, GetErrorType(err))
+	require.ErrorIs(t, Detach(con, ""), errEmptyName)
+}
+
+func TestAttachFile(t *testing.T) {
+	t.Parallel()
+	db := openDB(t)
+	defer db.Close()
+
+	con, err := db.Conn(context.Background())
+	require.NoError(t, err)
+	defer con.Close()
+
+	path := t.TempDir() + "/attached.db"
+	require.NoError(t, Attach(con, path, "a", false))
+	_, err = con.ExecContext(context.Background(), `CREATE TABLE a.t AS SELECT 42 AS i`)
+	require.NoError(t, err)
+
+	// Attaching an attached path under another alias is a catalog error.
+	err = Attach(con, path, "b", false)
+	require.ErrorIs(t, err, errAttach)
+	require.Equal(t, ErrorTypeCatalog, GetErrorType(err))
+	require.NoError(t, Detach(con, "a"))
+
+	// The default alias is the file name.
+	require.NoError(t, Attach(con, path, "", true))
+	var i int
+	require.NoError(t, con.QueryRowContext(context.Background(), `SELECT i FROM attached.t`).Scan(&i))
+	require.Equal(t, 42, i)
+
+	_, err = con.ExecContext(context.Background(), `INSERT INTO attached.t VALUES (1)`)
+	require.Equal(t, ErrorTypePermission, GetErrorType(err))
+	require.NoError(t, Detach(con, "attached"))
+}
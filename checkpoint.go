@@ -0,0 +1,63 @@
+package duckdb
+
+import (
+	"context"
+	"database/sql/driver"
+	"errors"
+	"io"
+)
+
+// Checkpoint writes the write-ahead log of the database file into the file, e.g., before a file-level backup.
+// It waits for running transactions to finish. Checkpointing an in-memory database returns an error,
+// as there is no file to write to.
+func (c *Connector) Checkpoint() error {
+	return c.checkpoint("CHECKPOINT")
+}
+
+// ForceCheckpoint is like Checkpoint, but it aborts running transactions instead of waiting for them.
+func (c *Connector) ForceCheckpoint() error {
+	return c.checkpoint("FORCE CHECKPOINT")
+}
+
+func (c *Connector) checkpoint(query string) error {
+	driverConn, err := c.Connect(context.Background())
+	if err != nil {
+		return err
+	}
+	defer driverConn.Close()
+	con := driverConn.(*conn)
+
+	// DuckDB silently ignores checkpoints of in-memory databases, which have no path.
+	inMemory, err := con.currentInMemory()
+	if err != nil {
+		return getError(errCheckpoint, err)
+	}
+	if inMemory {
+		return getError(errCheckpoint, errCheckpointInMemory)
+	}
+
+	if _, err = con.ExecContext(context.Background(), query, nil); err != nil {
+		return getError(errCheckpoint, err)
+	}
+	return nil
+}
+
+// currentInMemory returns true, if the current database of the connection is an in-memory database.
+// Contrary to Connector.IsInMemory, it also detects attached in-memory databases selected via USE.
+func (c *conn) currentInMemory() (bool, error) {
+	const query = `SELECT path IS NULL FROM duckdb_databases() WHERE database_name = current_database()`
+	res, err := c.QueryContext(context.Background(), query, nil)
+	if err != nil {
+		return false, err
+	}
+	defer res.Close()
+
+	dest := make([]driver.Value, 1)
+	if err = res.Next(dest); err != nil {
+		if errors.Is(err, io.EOF) {
+			return false, nil
+		}
+		return false, err
+	}
+	return dest[0] == true, nil
+}
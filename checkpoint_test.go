@@ -0,0 +1,73 @@
+package duckdb
+
+import (
+	"database/sql"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestCheckpoint(t *testing.T) {
+	t.Parallel()
+	path := filepath.Join(t.TempDir(), "checkpoint.db")
+	c, err := NewConnector(path, nil)
+	require.NoError(t, err)
+	db := sql.OpenDB(c)
+	defer db.Close()
+
+	_, err = db.Exec(`CREATE TABLE t AS SELECT range AS i FROM range(1000)`)
+	require.NoError(t, err)
+	walSize := func() int64 {
+		info, statErr := os.Stat(path + ".wal")
+		if os.IsNotExist(statErr) {
+			return 0
+		}
+		require.NoError(t, statErr)
+		return info.Size()
+	}
+	require.NotZero(t, walSize())
+
+	// A checkpoint moves the write-ahead log into the database file.
+	require.NoError(t, c.Checkpoint())
+	require.Zero(t, walSize())
+
+	_, err = db.Exec(`INSERT INTO t VALUES (1000)`)
+	require.NoError(t, err)
+	require.NoError(t, c.ForceCheckpoint())
+	require.Zero(t, walSize())
+
+	// In-memory databases have no file to checkpoint.
+	memory, err := NewConnector("", nil)
+	require.NoError(t, err)
+	defer memory.Close()
+	err = memory.Checkpoint()
+	require.ErrorIs(t, err, errCheckpoint)
+	require.ErrorIs(t, err, errCheckpointInMemory)
+}
+
+func TestIsInMemory(t *testing.T) {
+	t.Parallel()
+	isInMemory := func(c *Connector, err error) bool {
+		require.NoError(t, err)
+		defer c.Close()
+		return c.IsInMemory()
+	}
+
+	require.True(t, isInMemory(NewConnector("", nil)))
+	require.True(t, isInMemory(NewConnector("?threads=4", nil)))
+	require.True(t, isInMemory(NewConnectorWithConfig(":memory:", nil, nil)))
+
+	dir := t.TempDir()
+	path := filepath.Join(dir, "file.db")
+	require.False(t, isInMemory(NewConnector(path, nil)))
+	require.False(t, isInMemory(NewConnector(path+"?access_mode=READ_ONLY", nil)))
+	// DuckDB opens a named in-memory path as a file.
+	require.False(t, isInMemory(NewConnectorWithConfig(filepath.Join(dir, ":memory:named"), nil, nil)))
+
+	c, err := NewConnector("", nil)
+	require.NoError(t, err)
+	require.NoError(t, c.Close())
+	require.False(t, c.IsInMemory())
+}
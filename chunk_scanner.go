@@ -0,0 +1,187 @@
+package duckdb
+
+/*
+#include <duckdb.h>
+*/
+import "C"
+
+import (
+	"context"
+	"database/sql/driver"
+	"errors"
+	"io"
+	"unsafe"
+)
+
+// ChunkScanner iterates the result of a query in columnar batches, i.e., data chunks.
+// Each data chunk holds at most GetDataChunkCapacity() rows.
+type ChunkScanner struct {
+	r   *rows
+	err error
+	// started is true, if the scanner fetched the first data chunk.
+	started bool
+}
+
+// QueryChunks executes the query on a DuckDB driver connection and returns a ChunkScanner over its result.
+// Like QueryContext, it binds the arguments to the last statement of the query,
+// and the scanner iterates the result of the first statement returning rows.
+// You must close the scanner to free the underlying DuckDB result.
+func QueryChunks(ctx context.Context, driverConn driver.Conn, query string, args ...any) (*ChunkScanner, error) {
+	c, ok := driverConn.(*conn)
+	if !ok {
+		return nil, getError(errInvalidCon, nil)
+	}
+	if c.closed {
+		return nil, getError(errClosedCon, nil)
+	}
+
+	var nargs []driver.NamedValue
+	for i, arg := range args {
+		nargs = append(nargs, driver.NamedValue{Ordinal: i + 1, Value: arg})
+	}
+
+	r, err := c.QueryContext(ctx, query, nargs)
+	if err != nil {
+		return nil, err
+	}
+	return &ChunkScanner{r: r.(*rows)}, nil
+}
+
+// Columns returns the column names of the result.
+func (s *ChunkScanner) Columns() []string {
+	return s.r.Columns()
+}
+
+// Next fetches the next data chunk of the result. It returns false, if there are no more data chunks,
+// or if fetching the data chunk failed. Err returns the error, if any.
+// Fetching the next data chunk invalidates all column slices of the previous data chunk.
+func (s *ChunkScanner) Next() bool {
+	if s.err != nil || s.r.stmt == nil {
+		return false
+	}
+	err := s.r.nextChunk()
+	s.started = true
+	if errors.Is(err, io.EOF) {
+		return false
+	}
+	s.err = err
+	return err == nil
+}
+
+// Chunk returns the current data chunk. It is only valid after Next returned true,
+// and until the next call to Next or Close.
+func (s *ChunkScanner) Chunk() *DataChunk {
+	if !s.started {
+		return nil
+	}
+	return &s.r.chunk
+}
+
+// Err returns the error, if any, that occurred while fetching the data chunks.
+func (s *ChunkScanner) Err() error {
+	return s.err
+}
+
+// Close frees the underlying DuckDB result and invalidates the current data chunk.
+func (s *ChunkScanner) Close() error {
+	if s.r.stmt == nil {
+		return nil
+	}
+	return s.r.Close()
+}
+
+// NullMask returns the NULL values of a column, i.e., mask[rowIdx] is true, if the value at rowIdx is NULL.
+// It returns nil, if the column contains no NULL values.
+func (chunk *DataChunk) NullMask(colIdx int) ([]bool, error) {
+	if colIdx >= len(chunk.columns) {
+		return nil, getError(errAPI, columnCountError(colIdx, len(chunk.columns)))
+	}
+
+	column := &chunk.columns[colIdx]
+	if column.mask == nil {
+		return nil, nil
+	}
+
+	var mask []bool
+	for rowIdx := 0; rowIdx < chunk.size; rowIdx++ {
+		if column.getNull(C.idx_t(rowIdx)) {
+			if mask == nil {
+				mask = make([]bool, chunk.size)
+			}
+			mask[rowIdx] = true
+		}
+	}
+	return mask, nil
+}
+
+// Bools returns the values of a BOOLEAN column. The values of NULL rows are undefined.
+func (chunk *DataChunk) Bools(colIdx int) ([]bool, error) {
+	return columnData[bool](chunk, colIdx, TYPE_BOOLEAN, "[]bool")
+}
+
+// Int8s returns the values of a TINYINT column. The values of NULL rows are undefined.
+func (chunk *DataChunk) Int8s(colIdx int) ([]int8, error) {
+	return columnData[int8](chunk, colIdx, TYPE_TINYINT, "[]int8")
+}
+
+// Int16s returns the values of a SMALLINT column. The values of NULL rows are undefined.
+func (chunk *DataChunk) Int16s(colIdx int) ([]int16, error) {
+	return columnData[int16](chunk, colIdx, TYPE_SMALLINT, "[]int16")
+}
+
+// Int32s returns the values of an INTEGER column. The values of NULL rows are undefined.
+func (chunk *DataChunk) Int32s(colIdx int) ([]int32, error) {
+	return columnData[int32](chunk, colIdx, TYPE_INTEGER, "[]int32")
+}
+
+// Int64s returns the values of a BIGINT column. The values of NULL rows are undefined.
+func (chunk *DataChunk) Int64s(colIdx int) ([]int64, error) {
+	return columnData[int64](chunk, colIdx, TYPE_BIGINT, "[]int64")
+}
+
+// Uint8s returns the values of a UTINYINT column. The values of NULL rows are undefined.
+func (chunk *DataChunk) Uint8s(colIdx int) ([]uint8, error) {
+	return columnData[uint8](chunk, colIdx, TYPE_UTINYINT, "[]uint8")
+}
+
+// Uint16s returns the values of a USMALLINT column. The values of NULL rows are undefined.
+func (chunk *DataChunk) Uint16s(colIdx int) ([]uint16, error) {
+	return columnData[uint16](chunk, colIdx, TYPE_USMALLINT, "[]uint16")
+}
+
+// Uint32s returns the values of a UINTEGER column. The values of NULL rows are undefined.
+func (chunk *DataChunk) Uint32s(colIdx int) ([]uint32, error) {
+	return columnData[uint32](chunk, colIdx, TYPE_UINTEGER, "[]uint32")
+}
+
+// Uint64s returns the values of a UBIGINT column. The values of NULL rows are undefined.
+func (chunk *DataChunk) Uint64s(colIdx int) ([]uint64, error) {
+	return columnData[uint64](chunk, colIdx, TYPE_UBIGINT, "[]uint64")
+}
+
+// Float32s returns the values of a FLOAT column. The values of NULL rows are undefined.
+func (chunk *DataChunk) Float32s(colIdx int) ([]float32, error) {
+	return columnData[float32](chunk, colIdx, TYPE_FLOAT, "[]float32")
+}
+
+// Float64s returns the values of a DOUBLE column. The values of NULL rows are undefined.
+func (chunk *DataChunk) Float64s(colIdx int) ([]float64, error) {
+	return columnData[float64](chunk, colIdx, TYPE_DOUBLE, "[]float64")
+}
+
+// columnData returns the values of a primitive column without copying them.
+// The slice points to DuckDB memory, and is only valid as long as the data chunk.
+func columnData[T any](chunk *DataChunk, colIdx int, t Type, name string) ([]T, error) {
+	if colIdx >= len(chunk.columns) {
+		return nil, getError(errAPI, columnCountError(colIdx, len(chunk.columns)))
+	}
+
+	column := &chunk.columns[colIdx]
+	if column.Type != t {
+		return nil, getError(errAPI, castError(typeToStringMap[column.Type], name))
+	}
+	if chunk.size == 0 {
+		return []T{}, nil
+	}
+	return unsafe.Slice((*T)(column.ptr), chunk.size), nil
+}
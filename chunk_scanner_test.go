@@ -0,0 +1,154 @@
+package duckdb
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func queryChunks(t testing.TB, con *sql.Conn, query string, args ...any) *ChunkScanner {
+	var s *ChunkScanner
+	err := con.Raw(func(driverConn any) error {
+		var innerErr error
+		s, innerErr = QueryChunks(context.Background(), driverConn.(*conn), query, args...)
+		return innerErr
+	})
+	require.NoError(t, err)
+	return s
+}
+
+func TestChunkScanner(t *testing.T) {
+	t.Parallel()
+	db := openDB(t)
+	defer db.Close()
+
+	con, err := db.Conn(context.Background())
+	require.NoError(t, err)
+	defer con.Close()
+
+	s := queryChunks(t, con, `SELECT i::BIGINT AS i, i::DOUBLE / 2 AS d, CASE WHEN i % 3 = 0 THEN NULL ELSE i::INTEGER END AS n
+		FROM range(?) t(i)`, 5000)
+	require.Equal(t, []string{"i", "d", "n"}, s.Columns())
+
+	rowCount := 0
+	for s.Next() {
+		chunk := s.Chunk()
+		size := chunk.GetSize()
+		require.LessOrEqual(t, size, GetDataChunkCapacity())
+
+		ints, err := chunk.Int64s(0)
+		require.NoError(t, err)
+		require.Len(t, ints, size)
+		doubles, err := chunk.Float64s(1)
+		require.NoError(t, err)
+		nullable, err := chunk.Int32s(2)
+		require.NoError(t, err)
+
+		mask, err := chunk.NullMask(0)
+		require.NoError(t, err)
+		require.Nil(t, mask)
+		mask, err = chunk.NullMask(2)
+		require.NoError(t, err)
+		require.Len(t, mask, size)
+
+		for i := 0; i < size; i++ {
+			expected := int64(rowCount + i)
+			require.Equal(t, expected, ints[i])
+			require.Equal(t, float64(expected)/2, doubles[i])
+			require.Equal(t, expected%3 == 0, mask[i])
+			if !mask[i] {
+				require.Equal(t, int32(expected), nullable[i])
+			}
+		}
+		rowCount += size
+	}
+	require.NoError(t, s.Err())
+	require.Equal(t, 5000, rowCount)
+	require.NoError(t, s.Close())
+
+	// Close is idempotent, and Next returns false after Close.
+	require.NoError(t, s.Close())
+	require.False(t, s.Next())
+}
+
+func TestChunkScannerErrors(t *testing.T) {
+	t.Parallel()
+	db := openDB(t)
+	defer db.Close()
+
+	con, err := db.Conn(context.Background())
+	require.NoError(t, err)
+	defer con.Close()
+
+	err = con.Raw(func(driverConn any) error {
+		_, innerErr := QueryChunks(context.Background(), driverConn.(*conn), "SELECT * FROM does_not_exist")
+		return innerErr
+	})
+	require.ErrorContains(t, err, "does_not_exist")
+
+	s := queryChunks(t, con, "SELECT 42::INTEGER AS i")
+	defer s.Close()
+	require.True(t, s.Next())
+
+	_, err = s.Chunk().Int64s(0)
+	require.ErrorIs(t, err, errAPI)
+	require.ErrorContains(t, err, castErrMsg)
+	_, err = s.Chunk().Int32s(1)
+	require.ErrorContains(t, err, columnCountErrMsg)
+	_, err = s.Chunk().NullMask(1)
+	require.ErrorContains(t, err, columnCountErrMsg)
+
+	ints, err := s.Chunk().Int32s(0)
+	require.NoError(t, err)
+	require.Equal(t, []int32{42}, ints)
+	require.False(t, s.Next())
+	require.NoError(t, s.Err())
+}
+
+const benchmarkScanQuery = "SELECT i::BIGINT FROM range(10000000) t(i)"
+
+func BenchmarkScanRows(b *testing.B) {
+	db, err := sql.Open("duckdb", "")
+	require.NoError(b, err)
+	defer db.Close()
+
+	for n := 0; n < b.N; n++ {
+		res, err := db.Query(benchmarkScanQuery)
+		require.NoError(b, err)
+
+		var sum, i int64
+		for res.Next() {
+			require.NoError(b, res.Scan(&i))
+			sum += i
+		}
+		require.NoError(b, res.Err())
+		require.NoError(b, res.Close())
+	}
+}
+
+func BenchmarkScanChunks(b *testing.B) {
+	db, err := sql.Open("duckdb", "")
+	require.NoError(b, err)
+	defer db.Close()
+
+	con, err := db.Conn(context.Background())
+	require.NoError(b, err)
+	defer con.Close()
+
+	for n := 0; n < b.N; n++ {
+		s := queryChunks(b, con, benchmarkScanQuery)
+
+		var sum int64
+		for s.Next() {
+			ints, err := s.Chunk().Int64s(0)
+			require.NoError(b, err)
+			for _, i := range ints {
+				sum += i
+			}
+		}
+		require.NoError(b, s.Err())
+		require.NoError(b, s.Close())
+	}
+}
@@ -11,6 +11,9 @@ import (
 	"database/sql/driver"
 	"errors"
 	"math/big"
+	"reflect"
+	"sync"
+	"time"
 	"unsafe"
 )
 
@@ -18,17 +21,45 @@ type conn struct {
 	duckdbCon C.duckdb_connection
 	closed    bool
 	tx        bool
+	// bad is true, if DuckDB returned a fatal or internal error on the connection. The pool discards bad connections.
+	bad bool
+	// connector is the Connector that opened the connection.
+	connector *Connector
+	// queryTimeout is the default timeout of queries without a context deadline. Zero disables it.
+	queryTimeout time.Duration
+	// queryLogger is called after executing each query. Nil disables it.
+	queryLogger QueryLogger
+	// rejectNonFinite is true, if scanning NaN or infinite floats fails.
+	rejectNonFinite bool
+	// loc caches the location of the TimeZone setting. Executing a SET statement resets it.
+	loc *time.Location
+	// rawMu prevents closing the connection while RawConn uses its handles.
+	rawMu sync.Mutex
 }
 
 func (c *conn) CheckNamedValue(nv *driver.NamedValue) error {
 	switch nv.Value.(type) {
-	case *big.Int, Interval:
+	case *big.Int, Interval, Decimal, Bitstring, TimestampMicros, TimeTZ:
+		return nil
+	}
+	// We bind slices as LIST values, and maps as lists of their entries.
+	if kind := reflect.ValueOf(nv.Value).Kind(); kind == reflect.Slice || kind == reflect.Map {
 		return nil
 	}
 	return driver.ErrSkip
 }
 
 func (c *conn) ExecContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	if c.queryLogger == nil {
+		return c.execContext(ctx, query, args)
+	}
+	start := time.Now()
+	res, err := c.execContext(ctx, query, args)
+	c.queryLogger(query, args, time.Since(start), err)
+	return res, err
+}
+
+func (c *conn) execContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
 	if c.closed {
 		panic("database/sql/driver: misuse of duckdb driver: ExecContext after Close")
 	}
@@ -63,6 +94,16 @@ func (c *conn) ExecContext(ctx context.Context, query string, args []driver.Name
 }
 
 func (c *conn) QueryContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	if c.queryLogger == nil {
+		return c.queryContext(ctx, query, args)
+	}
+	start := time.Now()
+	r, err := c.queryContext(ctx, query, args)
+	c.queryLogger(query, args, time.Since(start), err)
+	return r, err
+}
+
+func (c *conn) queryContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
 	if c.closed {
 		panic("database/sql/driver: misuse of duckdb driver: QueryContext after Close")
 	}
@@ -71,44 +112,35 @@ func (c *conn) QueryContext(ctx context.Context, query string, args []driver.Nam
 	if err != nil {
 		return nil, err
 	}
-	defer C.duckdb_destroy_extracted(&stmts)
 
-	// execute all statements without args, except the last one
-	for i := C.idx_t(0); i < size-1; i++ {
-		stmt, err := c.prepareExtractedStmt(stmts, i)
-		if err != nil {
-			return nil, err
-		}
-		// send nil args to execute statement and ignore result (using ExecContext since we're ignoring the result anyway)
-		_, err = stmt.ExecContext(ctx, nil)
-		stmt.Close()
-		if err != nil {
-			return nil, err
-		}
+	// Each statement returning rows, and the last statement, yield a result set.
+	// We execute the statements lazily when advancing to the next result set.
+	pending := &pendingStmts{
+		ctx:   ctx,
+		c:     c,
+		stmts: stmts,
+		size:  size,
+		args:  args,
 	}
-
-	// prepare and execute last statement with args and return result
-	stmt, err := c.prepareExtractedStmt(stmts, size-1)
+	r, err := pending.nextRows()
 	if err != nil {
+		pending.close()
 		return nil, err
 	}
-
-	rows, err := stmt.QueryContext(ctx, args)
-	if err != nil {
-		stmt.Close()
-		return nil, err
-	}
-
-	// we can't close the statement before the query result rows are closed
-	stmt.closeOnRowsClose = true
-	return rows, err
+	r.pending = pending
+	return r, nil
 }
 
 func (c *conn) Prepare(cmd string) (driver.Stmt, error) {
 	if c.closed {
 		panic("database/sql/driver: misuse of duckdb driver: Prepare after Close")
 	}
-	return c.prepareStmt(cmd)
+	s, err := c.prepareStmt(cmd)
+	if err != nil {
+		return nil, err
+	}
+	s.query = cmd
+	return s, nil
 }
 
 // Deprecated: Use BeginTx instead.
@@ -121,17 +153,18 @@ func (c *conn) BeginTx(ctx context.Context, opts driver.TxOptions) (driver.Tx, e
 		panic("database/sql/driver: misuse of duckdb driver: multiple Tx")
 	}
 
-	if opts.ReadOnly {
-		return nil, errors.New("read-only transactions are not supported")
-	}
-
+	// DuckDB transactions use snapshot isolation.
 	switch sql.IsolationLevel(opts.Isolation) {
-	case sql.LevelDefault:
+	case sql.LevelDefault, sql.LevelSnapshot:
 	default:
-		return nil, errors.New("isolation levels other than default are not supported")
+		return nil, errors.New("isolation levels other than default and snapshot are not supported")
 	}
 
-	if _, err := c.ExecContext(ctx, "BEGIN TRANSACTION", nil); err != nil {
+	query := "BEGIN TRANSACTION"
+	if opts.ReadOnly {
+		query = "BEGIN TRANSACTION READ ONLY"
+	}
+	if _, err := c.ExecContext(ctx, query, nil); err != nil {
 		return nil, err
 	}
 
@@ -139,18 +172,88 @@ func (c *conn) BeginTx(ctx context.Context, opts driver.TxOptions) (driver.Tx, e
 	return &tx{c}, nil
 }
 
+// Ping implements driver.Pinger. It executes a trivial query to check the connection.
+// A closed or bad connection returns driver.ErrBadConn, so that the connection pool discards it.
+func (c *conn) Ping(ctx context.Context) error {
+	if c.closed || c.bad {
+		return driver.ErrBadConn
+	}
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	_, err := c.ExecContext(ctx, "SELECT 1", nil)
+	return err
+}
+
+// IsValid implements driver.Validator. The connection pool discards invalid connections instead of reusing them.
+// A connection becomes invalid after a fatal or internal DuckDB error, after which DuckDB cannot guarantee its state.
+func (c *conn) IsValid() bool {
+	return !c.closed && !c.bad
+}
+
+// ResetSession implements driver.SessionResetter. It returns driver.ErrBadConn for invalid connections,
+// so that the connection pool does not reuse them.
+func (c *conn) ResetSession(context.Context) error {
+	if !c.IsValid() {
+		return driver.ErrBadConn
+	}
+	return nil
+}
+
+// checkError marks the connection as bad, if err is a fatal or internal DuckDB error. It returns err unchanged,
+// so that the caller still receives the DuckDB error, whereas the connection pool discards the connection.
+func (c *conn) checkError(err error) error {
+	switch GetErrorType(err) {
+	case ErrorTypeFatal, ErrorTypeInternal:
+		c.bad = true
+	}
+	return err
+}
+
 func (c *conn) Close() error {
+	c.rawMu.Lock()
+	defer c.rawMu.Unlock()
 	if c.closed {
 		return errClosedCon
 	}
 	c.closed = true
 
+	// Remove the connection before disconnecting, so that Connector.Interrupt does not use a disconnected connection.
+	if c.connector != nil {
+		c.connector.removeConn(c)
+	}
 	C.duckdb_disconnect(&c.duckdbCon)
 
 	return nil
 }
 
-func (c *conn) prepareStmt(cmd string) (*stmt, error) {
+// location returns the location of the TimeZone setting of the connection.
+// It falls back to UTC, if the setting is unavailable, e.g., if the ICU extension is not loaded.
+func (c *conn) location() *time.Location {
+	if c.loc != nil {
+		return c.loc
+	}
+	c.loc = time.UTC
+
+	// We query duckdb_settings() instead of current_setting('TimeZone'), as the latter tries to autoload ICU.
+	query := C.CString(`SELECT value FROM duckdb_settings() WHERE name = 'TimeZone'`)
+	defer C.duckdb_free(unsafe.Pointer(query))
+
+	var res C.duckdb_result
+	defer C.duckdb_destroy_result(&res)
+	if state := C.duckdb_query(c.duckdbCon, query, &res); state == C.DuckDBError || C.duckdb_row_count(&res) == 0 {
+		return c.loc
+	}
+
+	name := C.duckdb_value_varchar(&res, 0, 0)
+	defer C.duckdb_free(unsafe.Pointer(name))
+	if loc, err := time.LoadLocation(C.GoString(name)); err == nil {
+		c.loc = loc
+	}
+	return c.loc
+}
+
+func (c *conn) prepareStmt(cmd string) (*Stmt, error) {
 	cmdStr := C.CString(cmd)
 	defer C.duckdb_free(unsafe.Pointer(cmdStr))
 
@@ -158,10 +261,34 @@ func (c *conn) prepareStmt(cmd string) (*stmt, error) {
 	if state := C.duckdb_prepare(c.duckdbCon, cmdStr, &s); state == C.DuckDBError {
 		dbErr := getDuckDBError(C.GoString(C.duckdb_prepare_error(s)))
 		C.duckdb_destroy_prepare(&s)
-		return nil, dbErr
+		return nil, c.checkError(dbErr)
 	}
 
-	return &stmt{c: c, stmt: &s}, nil
+	return &Stmt{c: c, stmt: &s}, nil
+}
+
+// execScript executes all statements of the script in order, and returns the result of the last statement.
+// If a statement fails, then execScript does not execute the remaining statements.
+func (c *conn) execScript(ctx context.Context, script string) (driver.Result, error) {
+	stmts, size, err := c.extractStmts(script)
+	if err != nil {
+		return nil, getError(errExecScript, err)
+	}
+	defer C.duckdb_destroy_extracted(&stmts)
+
+	var res driver.Result
+	for i := C.idx_t(0); i < size; i++ {
+		var stmt *Stmt
+		stmt, err = c.prepareExtractedStmt(stmts, i)
+		if err == nil {
+			res, err = stmt.ExecContext(ctx, nil)
+			stmt.Close()
+		}
+		if err != nil {
+			return nil, getError(errExecScript, scriptStmtError(err, int(i)))
+		}
+	}
+	return res, nil
 }
 
 func (c *conn) extractStmts(query string) (C.duckdb_extracted_statements, C.idx_t, error) {
@@ -182,13 +309,13 @@ func (c *conn) extractStmts(query string) (C.duckdb_extracted_statements, C.idx_
 	return stmts, stmtsCount, nil
 }
 
-func (c *conn) prepareExtractedStmt(extractedStmts C.duckdb_extracted_statements, index C.idx_t) (*stmt, error) {
+func (c *conn) prepareExtractedStmt(extractedStmts C.duckdb_extracted_statements, index C.idx_t) (*Stmt, error) {
 	var s C.duckdb_prepared_statement
 	if state := C.duckdb_prepare_extracted_statement(c.duckdbCon, extractedStmts, index, &s); state == C.DuckDBError {
 		dbErr := getDuckDBError(C.GoString(C.duckdb_prepare_error(s)))
 		C.duckdb_destroy_prepare(&s)
-		return nil, dbErr
+		return nil, c.checkError(dbErr)
 	}
 
-	return &stmt{c: c, stmt: &s}, nil
+	return &Stmt{c: c, stmt: &s}, nil
 }
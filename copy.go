@@ -0,0 +1,184 @@
+package duckdb
+
+import (
+	"context"
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+)
+
+// CopyOptions configure how CopyFrom reads a file.
+type CopyOptions struct {
+	// Format is the format of the file, i.e., csv, parquet, or json.
+	// If empty, CopyFrom detects the format from the file extension, e.g., .parquet or .csv.gz.
+	Format string
+	// Header is true, if the first line of a CSV file contains the column names.
+	Header bool
+	// Delimiter separates the values of a CSV file. If empty, DuckDB detects it.
+	Delimiter string
+	// Columns are the table columns in the file, in the order of the file. If empty, the file contains all columns.
+	Columns []string
+}
+
+// copyFormats maps file extensions to the COPY formats.
+var copyFormats = map[string]string{
+	".csv":     "csv",
+	".tsv":     "csv",
+	".parquet": "parquet",
+	".json":    "json",
+	".ndjson":  "json",
+	".jsonl":   "json",
+}
+
+// CopyFrom loads the file at path into the table with a COPY statement on a new connection,
+// and returns the number of loaded rows. The table must exist. Unlike a query,
+// the table name is quoted, so it cannot contain a schema.
+func (c *Connector) CopyFrom(ctx context.Context, table string, path string, opts CopyOptions) (int64, error) {
+	query, err := copyFromQuery(table, path, opts)
+	if err != nil {
+		return 0, getError(errCopyFrom, err)
+	}
+
+	driverConn, err := c.Connect(ctx)
+	if err != nil {
+		return 0, err
+	}
+	defer driverConn.Close()
+
+	res, err := driverConn.(*conn).ExecContext(ctx, query, nil)
+	if err != nil {
+		return 0, getError(errCopyFrom, err)
+	}
+	return res.RowsAffected()
+}
+
+func copyFromQuery(table string, path string, opts CopyOptions) (string, error) {
+	if table == "" {
+		return "", errEmptyName
+	}
+
+	format := strings.ToLower(opts.Format)
+	if format == "" {
+		// Ignore the extension of compressed files, e.g., .gz in data.csv.gz.
+		ext := strings.ToLower(filepath.Ext(path))
+		if ext == ".gz" || ext == ".zst" {
+			ext = strings.ToLower(filepath.Ext(strings.TrimSuffix(path, filepath.Ext(path))))
+		}
+		var ok bool
+		if format, ok = copyFormats[ext]; !ok {
+			return "", unknownCopyFormatError(path)
+		}
+	}
+
+	options := []string{"FORMAT " + format}
+	switch format {
+	case "csv":
+		if opts.Header {
+			options = append(options, "HEADER true")
+		}
+		if opts.Delimiter != "" {
+			options = append(options, "DELIMITER "+quoteString(opts.Delimiter))
+		}
+	case "parquet", "json":
+		if opts.Header || opts.Delimiter != "" {
+			return "", errCopyCSVOptions
+		}
+	default:
+		return "", unknownCopyFormatError(opts.Format)
+	}
+
+	query := "COPY " + quoteIdentifier(table)
+	if len(opts.Columns) != 0 {
+		columns := make([]string, len(opts.Columns))
+		for i, column := range opts.Columns {
+			columns[i] = quoteIdentifier(column)
+		}
+		query += " (" + strings.Join(columns, ", ") + ")"
+	}
+	return query + " FROM " + quoteString(path) + " (" + strings.Join(options, ", ") + ")", nil
+}
+
+// ExportFormat configures the file format of ExportQuery.
+type ExportFormat struct {
+	// Format is the format of the file, i.e., csv or parquet.
+	Format string
+	// Header is true, if the first line of a CSV file contains the column names.
+	Header bool
+	// Delimiter separates the values of a CSV file. If empty, it is a comma.
+	Delimiter string
+	// Compression is the compression of the file, e.g., gzip for CSV, or snappy and zstd for Parquet.
+	// If empty, it is DuckDB's default, i.e., none for CSV, and snappy for Parquet.
+	Compression string
+}
+
+// ExportQuery writes the result of a query to w in the given format with a COPY statement on a new connection,
+// and returns the number of exported rows. The query must be a single statement returning rows.
+// As COPY can only write to files, ExportQuery writes the result to a temporary file in os.TempDir,
+// and then copies the file to w. It removes the temporary file before returning.
+func (c *Connector) ExportQuery(ctx context.Context, query string, w io.Writer, format ExportFormat) (n int64, err error) {
+	options, err := exportOptions(format)
+	if err != nil {
+		return 0, getError(errExportQuery, err)
+	}
+
+	f, err := os.CreateTemp("", "duckdb-export-*."+strings.ToLower(format.Format))
+	if err != nil {
+		return 0, getError(errExportQuery, err)
+	}
+	defer func() {
+		err = errors.Join(err, f.Close(), os.Remove(f.Name()))
+	}()
+
+	driverConn, err := c.Connect(ctx)
+	if err != nil {
+		return 0, err
+	}
+	defer driverConn.Close()
+
+	// A newline before the closing parenthesis keeps a trailing comment of the query from commenting it out.
+	query = strings.TrimRight(strings.TrimSpace(query), ";")
+	copyQuery := "COPY (" + query + "\n) TO " + quoteString(f.Name()) + " (" + options + ")"
+	res, err := driverConn.(*conn).ExecContext(ctx, copyQuery, nil)
+	if err != nil {
+		return 0, getError(errExportQuery, err)
+	}
+	if n, err = res.RowsAffected(); err != nil {
+		return 0, getError(errExportQuery, err)
+	}
+
+	// DuckDB replaced the file, so we read it via its name instead of the handle of CreateTemp.
+	exported, err := os.Open(f.Name())
+	if err != nil {
+		return 0, getError(errExportQuery, err)
+	}
+	defer exported.Close()
+	if _, err = io.Copy(w, exported); err != nil {
+		return 0, getError(errExportQuery, err)
+	}
+	return n, nil
+}
+
+func exportOptions(format ExportFormat) (string, error) {
+	f := strings.ToLower(format.Format)
+	options := []string{"FORMAT " + f}
+	switch f {
+	case "csv":
+		options = append(options, "HEADER "+strconv.FormatBool(format.Header))
+		if format.Delimiter != "" {
+			options = append(options, "DELIMITER "+quoteString(format.Delimiter))
+		}
+	case "parquet":
+		if format.Header || format.Delimiter != "" {
+			return "", errCopyCSVOptions
+		}
+	default:
+		return "", unknownCopyFormatError(format.Format)
+	}
+	if format.Compression != "" {
+		options = append(options, "COMPRESSION "+quoteString(format.Compression))
+	}
+	return strings.Join(options, ", "), nil
+}
@@ -0,0 +1,134 @@
+package duckdb
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestCopyFrom(t *testing.T) {
+	t.Parallel()
+	c, err := NewConnector("", nil)
+	require.NoError(t, err)
+	db := sql.OpenDB(c)
+	defer db.Close()
+
+	_, err = db.Exec(`CREATE TABLE pets (id INTEGER, name VARCHAR, species VARCHAR, owner VARCHAR DEFAULT 'none')`)
+	require.NoError(t, err)
+	columns := []string{"id", "name", "species"}
+
+	n, err := c.CopyFrom(context.Background(), "pets", "testdata/pets.csv", CopyOptions{Header: true, Delimiter: ";", Columns: columns})
+	require.NoError(t, err)
+	require.Equal(t, int64(3), n)
+
+	n, err = c.CopyFrom(context.Background(), "pets", "testdata/pets.parquet", CopyOptions{Columns: columns})
+	require.NoError(t, err)
+	require.Equal(t, int64(3), n)
+
+	// An explicit format overrides the file extension, and paths are quoted.
+	dir := t.TempDir()
+	path := filepath.Join(dir, "it's pets.data")
+	require.NoError(t, os.WriteFile(path, []byte(`{"id": 4, "name": "Nemo", "species": "fish", "owner": "Marlin"}`), 0o600))
+	n, err = c.CopyFrom(context.Background(), "pets", path, CopyOptions{Format: "JSON"})
+	require.NoError(t, err)
+	require.Equal(t, int64(1), n)
+
+	rows, err := db.Query(`SELECT id, name, species, owner FROM pets ORDER BY id, name`)
+	require.NoError(t, err)
+	type pet struct {
+		id                   int32
+		name, species, owner string
+	}
+	var pets []pet
+	for rows.Next() {
+		var p pet
+		require.NoError(t, rows.Scan(&p.id, &p.name, &p.species, &p.owner))
+		pets = append(pets, p)
+	}
+	require.NoError(t, rows.Err())
+	require.NoError(t, rows.Close())
+	require.Equal(t, []pet{
+		{1, "Gopher", "gopher", "none"}, {1, "Gopher", "gopher", "none"},
+		{2, "Donald", "duck", "none"}, {2, "Donald", "duck", "none"},
+		{3, "Semi;colon", "cat", "none"}, {3, "Semi;colon", "cat", "none"},
+		{4, "Nemo", "fish", "Marlin"},
+	}, pets)
+}
+
+func TestCopyFromErrors(t *testing.T) {
+	t.Parallel()
+	c, err := NewConnector("", nil)
+	require.NoError(t, err)
+	defer c.Close()
+
+	_, err = c.CopyFrom(context.Background(), "", "testdata/pets.csv", CopyOptions{})
+	require.ErrorIs(t, err, errCopyFrom)
+	require.ErrorIs(t, err, errEmptyName)
+
+	_, err = c.CopyFrom(context.Background(), "pets", "testdata/pets.sqlite", CopyOptions{})
+	require.ErrorIs(t, err, errUnknownCopyFormat)
+	_, err = c.CopyFrom(context.Background(), "pets", "testdata/pets.csv", CopyOptions{Format: "xml"})
+	require.ErrorIs(t, err, errUnknownCopyFormat)
+	_, err = c.CopyFrom(context.Background(), "pets", "testdata/pets.parquet", CopyOptions{Header: true})
+	require.ErrorIs(t, err, errCopyCSVOptions)
+
+	_, err = c.CopyFrom(context.Background(), "does_not_exist", "testdata/pets.csv", CopyOptions{Header: true})
+	require.ErrorIs(t, err, errCopyFrom)
+	require.ErrorIs(t, err, ErrCatalog)
+}
+
+func TestExportQuery(t *testing.T) {
+	// The temporary files of ExportQuery go to TMPDIR.
+	tmpDir := t.TempDir()
+	t.Setenv("TMPDIR", tmpDir)
+
+	c, err := NewConnector("", nil)
+	require.NoError(t, err)
+	db := sql.OpenDB(c)
+	defer db.Close()
+
+	const query = `SELECT i AS id, 'name ' || i AS name FROM range(3) t(i) ORDER BY i -- trailing comment`
+
+	var buf bytes.Buffer
+	n, err := c.ExportQuery(context.Background(), query+";", &buf, ExportFormat{Format: "csv", Header: true, Delimiter: ";"})
+	require.NoError(t, err)
+	require.Equal(t, int64(3), n)
+	require.Equal(t, "id;name\n0;name 0\n1;name 1\n2;name 2\n", buf.String())
+
+	buf.Reset()
+	n, err = c.ExportQuery(context.Background(), query, &buf, ExportFormat{Format: "CSV"})
+	require.NoError(t, err)
+	require.Equal(t, int64(3), n)
+	require.Equal(t, "0,name 0\n1,name 1\n2,name 2\n", buf.String())
+
+	// Read the exported Parquet file back.
+	buf.Reset()
+	n, err = c.ExportQuery(context.Background(), query, &buf, ExportFormat{Format: "parquet", Compression: "zstd"})
+	require.NoError(t, err)
+	require.Equal(t, int64(3), n)
+	path := filepath.Join(t.TempDir(), "export.parquet")
+	require.NoError(t, os.WriteFile(path, buf.Bytes(), 0o600))
+	var count, sum int64
+	require.NoError(t, db.QueryRow(`SELECT count(*), sum(id) FROM read_parquet(?)`, path).Scan(&count, &sum))
+	require.Equal(t, int64(3), count)
+	require.Equal(t, int64(3), sum)
+
+	// Errors.
+	_, err = c.ExportQuery(context.Background(), query, &buf, ExportFormat{Format: "xlsx"})
+	testError(t, err, errExportQuery.Error(), errUnknownCopyFormat.Error())
+	_, err = c.ExportQuery(context.Background(), query, &buf, ExportFormat{Format: "parquet", Header: true})
+	testError(t, err, errExportQuery.Error(), errCopyCSVOptions.Error())
+	_, err = c.ExportQuery(context.Background(), `SELECT * FROM does_not_exist`, &buf, ExportFormat{Format: "csv"})
+	testError(t, err, errExportQuery.Error())
+	require.Equal(t, ErrorTypeCatalog, GetErrorType(err))
+
+	// ExportQuery removes its temporary files.
+	entries, err := os.ReadDir(tmpDir)
+	require.NoError(t, err)
+	require.Empty(t, entries)
+}
@@ -0,0 +1,272 @@
+package duckdb
+
+import (
+	"context"
+	"database/sql/driver"
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// CreateTableOptions configures CreateTableFromStruct.
+type CreateTableOptions struct {
+	// Schema is the schema of the table. Empty selects the default schema of the connection.
+	Schema string
+	// IfNotExists keeps an existing table instead of failing.
+	IfNotExists bool
+	// OrReplace replaces an existing table.
+	OrReplace bool
+}
+
+var (
+	reflectTypeBytes   = reflect.TypeOf([]byte{})
+	reflectTypeUUID    = reflect.TypeOf(UUID{})
+	reflectTypeDecimal = reflect.TypeOf(Decimal{})
+	reflectTypeTimeTZ  = reflect.TypeOf(TimeTZ{})
+	reflectTypeBitstr  = reflect.TypeOf(Bitstring{})
+)
+
+// CreateTableFromStruct creates the table name with one column per exported field of model,
+// which is a struct or a pointer to a struct, so that AppendStructs can append such structs to the table.
+//
+// A field maps to the column with the same name, or to the column set in its `db` tag. A `db:"-"` tag skips the field.
+// The `duckdb` tag holds comma-separated column options: not_null adds a NOT NULL constraint, primary_key adds
+// the column to the primary key, and type=<type> sets the column type, e.g., `duckdb:"type=DECIMAL(18,4),not_null"`.
+// Without a type option, the column type follows from the field type, e.g., BIGINT for int64, VARCHAR for string,
+// TIMESTAMP for time.Time, BLOB for []byte, UUID for [16]byte, T[] for slices, STRUCT for structs,
+// and MAP for maps. Pointers map to the type of their element.
+func (c *Connector) CreateTableFromStruct(ctx context.Context, name string, model any, opts CreateTableOptions) error {
+	query, err := createTableQuery(name, model, opts)
+	if err != nil {
+		return getError(errCreateTable, err)
+	}
+
+	driverConn, err := c.Connect(ctx)
+	if err != nil {
+		return err
+	}
+	defer driverConn.Close()
+
+	if _, err = driverConn.(driver.ExecerContext).ExecContext(ctx, query, nil); err != nil {
+		return getError(errCreateTable, err)
+	}
+	return nil
+}
+
+// createTableQuery returns the CREATE TABLE statement of CreateTableFromStruct.
+func createTableQuery(name string, model any, opts CreateTableOptions) (string, error) {
+	if name == "" {
+		return "", errEmptyName
+	}
+	if opts.IfNotExists && opts.OrReplace {
+		return "", errCreateTableOptions
+	}
+
+	t := reflect.TypeOf(model)
+	if t != nil && t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	if t == nil || t.Kind() != reflect.Struct {
+		return "", castError(fmt.Sprint(reflect.TypeOf(model)), "struct")
+	}
+
+	var columns, primaryKey []string
+	names := make(map[string]bool)
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		if !field.IsExported() {
+			continue
+		}
+		column := field.Name
+		if tag, ok := field.Tag.Lookup("db"); ok {
+			if tag == "-" {
+				continue
+			}
+			column = tag
+		}
+		// DuckDB column names are case-insensitive.
+		if names[strings.ToLower(column)] {
+			return "", duplicateNameError(column)
+		}
+		names[strings.ToLower(column)] = true
+
+		var typeName string
+		var notNull bool
+		for _, opt := range splitTagOptions(field.Tag.Get("duckdb")) {
+			switch opt = strings.TrimSpace(opt); {
+			case opt == "":
+			case opt == "not_null":
+				notNull = true
+			case opt == "primary_key":
+				primaryKey = append(primaryKey, quoteIdentifier(column))
+			case strings.HasPrefix(opt, "type="):
+				typeName = strings.TrimPrefix(opt, "type=")
+			default:
+				return "", structFieldNameError(fmt.Errorf("unknown duckdb tag option %q", opt), field.Name)
+			}
+		}
+		if typeName == "" {
+			var err error
+			if typeName, err = goTypeToDuckDB(field.Type); err != nil {
+				return "", structFieldNameError(err, field.Name)
+			}
+		}
+
+		column = quoteIdentifier(column) + " " + typeName
+		if notNull {
+			column += " NOT NULL"
+		}
+		columns = append(columns, column)
+	}
+	if len(columns) == 0 {
+		return "", castError(t.String(), "struct with exported fields")
+	}
+	if len(primaryKey) != 0 {
+		columns = append(columns, "PRIMARY KEY ("+strings.Join(primaryKey, ", ")+")")
+	}
+
+	query := "CREATE TABLE "
+	if opts.OrReplace {
+		query = "CREATE OR REPLACE TABLE "
+	}
+	if opts.IfNotExists {
+		query += "IF NOT EXISTS "
+	}
+	if opts.Schema != "" {
+		query += quoteIdentifier(opts.Schema) + "."
+	}
+	return query + quoteIdentifier(name) + " (" + strings.Join(columns, ", ") + ")", nil
+}
+
+// splitTagOptions splits the comma-separated options of a tag, except for commas within parentheses,
+// e.g., in type=DECIMAL(18,4).
+func splitTagOptions(tag string) []string {
+	var opts []string
+	depth, start := 0, 0
+	for i, r := range tag {
+		switch r {
+		case '(':
+			depth++
+		case ')':
+			depth--
+		case ',':
+			if depth == 0 {
+				opts = append(opts, tag[start:i])
+				start = i + 1
+			}
+		}
+	}
+	return append(opts, tag[start:])
+}
+
+// goTypeToDuckDB returns the DuckDB type of the column, to which the appender appends values of type t.
+func goTypeToDuckDB(t reflect.Type) (string, error) {
+	return goTypeToDuckDBVisiting(t, make(map[reflect.Type]bool))
+}
+
+// goTypeToDuckDBVisiting is goTypeToDuckDB, where visiting holds the struct types enclosing t.
+// A struct type containing itself, e.g., via a slice, has no DuckDB type.
+func goTypeToDuckDBVisiting(t reflect.Type, visiting map[reflect.Type]bool) (string, error) {
+	switch t {
+	case reflectTypeTime:
+		return "TIMESTAMP", nil
+	case reflectTypeInterval:
+		return "INTERVAL", nil
+	case reflectTypeBigInt:
+		return "HUGEINT", nil
+	case reflectTypeBytes:
+		return "BLOB", nil
+	case reflectTypeDecimal:
+		// The type of a Decimal depends on its width and scale, so we use DuckDB's default.
+		return "DECIMAL", nil
+	case reflectTypeTimeTZ:
+		return "TIMETZ", nil
+	case reflectTypeBitstr:
+		return "BIT", nil
+	}
+	if t.Kind() == reflect.Array && t.ConvertibleTo(reflectTypeUUID) {
+		return "UUID", nil
+	}
+
+	switch t.Kind() {
+	case reflect.Bool:
+		return "BOOLEAN", nil
+	case reflect.Int8:
+		return "TINYINT", nil
+	case reflect.Int16:
+		return "SMALLINT", nil
+	case reflect.Int32:
+		return "INTEGER", nil
+	case reflect.Int, reflect.Int64:
+		return "BIGINT", nil
+	case reflect.Uint8:
+		return "UTINYINT", nil
+	case reflect.Uint16:
+		return "USMALLINT", nil
+	case reflect.Uint32:
+		return "UINTEGER", nil
+	case reflect.Uint, reflect.Uint64:
+		return "UBIGINT", nil
+	case reflect.Float32:
+		return "FLOAT", nil
+	case reflect.Float64:
+		return "DOUBLE", nil
+	case reflect.String:
+		return "VARCHAR", nil
+	case reflect.Pointer:
+		return goTypeToDuckDBVisiting(t.Elem(), visiting)
+	case reflect.Slice:
+		elem, err := goTypeToDuckDBVisiting(t.Elem(), visiting)
+		if err != nil {
+			return "", err
+		}
+		return elem + "[]", nil
+	case reflect.Array:
+		elem, err := goTypeToDuckDBVisiting(t.Elem(), visiting)
+		if err != nil {
+			return "", err
+		}
+		return fmt.Sprintf("%s[%d]", elem, t.Len()), nil
+	case reflect.Map:
+		key, err := goTypeToDuckDBVisiting(t.Key(), visiting)
+		if err != nil {
+			return "", err
+		}
+		value, err := goTypeToDuckDBVisiting(t.Elem(), visiting)
+		if err != nil {
+			return "", err
+		}
+		return "MAP(" + key + ", " + value + ")", nil
+	case reflect.Struct:
+		if visiting[t] {
+			return "", unsupportedTypeError(t.String())
+		}
+		visiting[t] = true
+		defer delete(visiting, t)
+
+		var entries []string
+		for i := 0; i < t.NumField(); i++ {
+			field := t.Field(i)
+			if !field.IsExported() {
+				continue
+			}
+			name := field.Name
+			if tag, ok := field.Tag.Lookup("db"); ok {
+				if tag == "-" {
+					continue
+				}
+				name = tag
+			}
+			typeName, err := goTypeToDuckDBVisiting(field.Type, visiting)
+			if err != nil {
+				return "", err
+			}
+			entries = append(entries, quoteIdentifier(name)+" "+typeName)
+		}
+		if len(entries) == 0 {
+			return "", unsupportedTypeError(t.String())
+		}
+		return "STRUCT(" + strings.Join(entries, ", ") + ")", nil
+	}
+	return "", unsupportedTypeError(t.String())
+}
@@ -0,0 +1,156 @@
+package duckdb
+
+import (
+	"context"
+	"database/sql"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+type createTableAddress struct {
+	Street string
+	Zip    int32  `db:"zip_code"`
+	Note   string `db:"-"`
+}
+
+type createTableRow struct {
+	ID        int64  `db:"id" duckdb:"primary_key"`
+	Name      string `duckdb:"not_null"`
+	Score     *float64
+	Active    bool
+	Small     int16
+	Count     uint32
+	Price     Decimal  `duckdb:"type=DECIMAL(10,2)"`
+	Big       *big.Int `db:"big"`
+	Tags      []string `db:"tags"`
+	Point     [2]float32
+	Attrs     map[string]int64
+	Address   createTableAddress
+	Blob      []byte
+	Key       uuid.UUID
+	CreatedAt time.Time `db:"created_at" duckdb:"not_null"`
+	Ignored   string    `db:"-"`
+	internal  int
+}
+
+type createTableNode struct {
+	Name     string
+	Children []createTableNode
+}
+
+func TestCreateTableQuery(t *testing.T) {
+	t.Parallel()
+
+	query, err := createTableQuery("items", &createTableRow{}, CreateTableOptions{})
+	require.NoError(t, err)
+	require.Equal(t, `CREATE TABLE "items" (`+
+		`"id" BIGINT, "Name" VARCHAR NOT NULL, "Score" DOUBLE, "Active" BOOLEAN, "Small" SMALLINT, "Count" UINTEGER, `+
+		`"Price" DECIMAL(10,2), "big" HUGEINT, "tags" VARCHAR[], "Point" FLOAT[2], "Attrs" MAP(VARCHAR, BIGINT), `+
+		`"Address" STRUCT("Street" VARCHAR, "zip_code" INTEGER), "Blob" BLOB, "Key" UUID, "created_at" TIMESTAMP NOT NULL, `+
+		`PRIMARY KEY ("id"))`, query)
+
+	type pair struct {
+		A int32 `duckdb:"primary_key"`
+		B int32 `duckdb:"primary_key"`
+	}
+	query, err = createTableQuery("pairs", pair{}, CreateTableOptions{Schema: "s", IfNotExists: true})
+	require.NoError(t, err)
+	require.Equal(t, `CREATE TABLE IF NOT EXISTS "s"."pairs" ("A" INTEGER, "B" INTEGER, PRIMARY KEY ("A", "B"))`, query)
+
+	query, err = createTableQuery("pairs", pair{}, CreateTableOptions{OrReplace: true})
+	require.NoError(t, err)
+	require.Equal(t, `CREATE OR REPLACE TABLE "pairs" ("A" INTEGER, "B" INTEGER, PRIMARY KEY ("A", "B"))`, query)
+
+	// Invalid models and options.
+	_, err = createTableQuery("", pair{}, CreateTableOptions{})
+	require.ErrorIs(t, err, errEmptyName)
+	_, err = createTableQuery("t", pair{}, CreateTableOptions{IfNotExists: true, OrReplace: true})
+	require.ErrorIs(t, err, errCreateTableOptions)
+	_, err = createTableQuery("t", 42, CreateTableOptions{})
+	require.ErrorContains(t, err, castErrMsg)
+	_, err = createTableQuery("t", struct{ C chan int }{}, CreateTableOptions{})
+	require.ErrorContains(t, err, unsupportedTypeErrMsg)
+	_, err = createTableQuery("t", struct {
+		A int `duckdb:"unique"`
+	}{}, CreateTableOptions{})
+	require.ErrorContains(t, err, "unique")
+	_, err = createTableQuery("t", struct {
+		A int `db:"x"`
+		B int `db:"X"`
+	}{}, CreateTableOptions{})
+	require.ErrorContains(t, err, duplicateNameErrMsg)
+
+	// Nested structs skip `db:"-"` fields, and self-referential structs have no DuckDB type.
+	type inner struct {
+		A    int32
+		Skip string `db:"-"`
+	}
+	query, err = createTableQuery("t", struct{ Inner inner }{}, CreateTableOptions{})
+	require.NoError(t, err)
+	require.Equal(t, `CREATE TABLE "t" ("Inner" STRUCT("A" INTEGER))`, query)
+	_, err = createTableQuery("t", createTableNode{}, CreateTableOptions{})
+	require.ErrorContains(t, err, unsupportedTypeErrMsg)
+	require.ErrorContains(t, err, "createTableNode")
+}
+
+func TestCreateTableFromStruct(t *testing.T) {
+	t.Parallel()
+	c, err := NewConnector("", nil)
+	require.NoError(t, err)
+	db := sql.OpenDB(c)
+	ctx := context.Background()
+
+	require.NoError(t, c.CreateTableFromStruct(ctx, "items", createTableRow{}, CreateTableOptions{}))
+	err = c.CreateTableFromStruct(ctx, "items", createTableRow{}, CreateTableOptions{})
+	testError(t, err, errCreateTable.Error())
+	require.Equal(t, ErrorTypeCatalog, GetErrorType(err))
+	require.NoError(t, c.CreateTableFromStruct(ctx, "items", createTableRow{}, CreateTableOptions{IfNotExists: true}))
+
+	// AppendStructs appends the structs to the table.
+	con, err := c.Connect(ctx)
+	require.NoError(t, err)
+	a, err := NewAppenderFromConn(con, "", "items")
+	require.NoError(t, err)
+
+	score := 1.5
+	createdAt := time.Date(2024, 5, 6, 7, 8, 9, 0, time.UTC)
+	rows := []createTableRow{
+		{
+			ID: 1, Name: "duck", Score: &score, Active: true, Small: -3, Count: 7,
+			Price: Decimal{Width: 10, Scale: 2, Value: big.NewInt(1999)}, Big: big.NewInt(42),
+			Tags: []string{"a", "b"}, Point: [2]float32{1, 2}, Attrs: map[string]int64{"x": 1},
+			Address: createTableAddress{Street: "pond", Zip: 12345, Note: "skipped"}, Blob: []byte{1, 2},
+			Key: uuid.New(), CreatedAt: createdAt, Ignored: "ignored",
+		},
+		{
+			ID: 2, Name: "goose", Price: Decimal{Width: 10, Scale: 2, Value: big.NewInt(500)}, Big: big.NewInt(0),
+			Key: uuid.New(), CreatedAt: createdAt,
+		},
+	}
+	require.NoError(t, a.AppendStructs(rows))
+	require.NoError(t, a.Close())
+	require.NoError(t, con.Close())
+
+	var name string
+	var price Decimal
+	var zip int32
+	require.NoError(t, db.QueryRow(`SELECT "Name", "Price", "Address".zip_code FROM items WHERE id = 1`).Scan(&name, &price, &zip))
+	require.Equal(t, "duck", name)
+	require.Equal(t, 19.99, price.Float64())
+	require.Equal(t, int32(12345), zip)
+	var bigVal *big.Int
+	require.NoError(t, db.QueryRow(`SELECT big FROM items WHERE id = 1`).Scan(&bigVal))
+	require.Equal(t, int64(42), bigVal.Int64())
+
+	// The primary key and NOT NULL constraints apply.
+	_, err = db.Exec(`INSERT INTO items (id, "Name", created_at) VALUES (1, 'duplicate', now())`)
+	require.ErrorIs(t, err, ErrConstraintViolation)
+	_, err = db.Exec(`INSERT INTO items (id, created_at) VALUES (3, now())`)
+	require.ErrorIs(t, err, ErrConstraintViolation)
+
+	require.NoError(t, db.Close())
+}
@@ -6,6 +6,7 @@ package duckdb
 import "C"
 
 import (
+	"fmt"
 	"unsafe"
 )
 
@@ -21,7 +22,18 @@ type DataChunk struct {
 	size int
 }
 
-// GetDataChunkCapacity returns the capacity of a data chunk.
+// VectorSize is DuckDB's internal vector size, which is the maximum number of rows of a data chunk.
+// It is STANDARD_VECTOR_SIZE of DuckDB's default build. The package panics on initialization,
+// if the linked DuckDB library uses a different vector size.
+const VectorSize = 2048
+
+func init() {
+	if capacity := GetDataChunkCapacity(); capacity != VectorSize {
+		panic(fmt.Sprintf("database/sql/driver: the vector size of the linked DuckDB library is %d, expected %d", capacity, VectorSize))
+	}
+}
+
+// GetDataChunkCapacity returns the capacity of a data chunk, which equals VectorSize.
 func GetDataChunkCapacity() int {
 	return int(C.duckdb_vector_size())
 }
@@ -35,7 +47,7 @@ func (chunk *DataChunk) GetSize() int {
 // SetSize sets the internal size of the data chunk. Cannot exceed GetCapacity().
 func (chunk *DataChunk) SetSize(size int) error {
 	if size > GetDataChunkCapacity() {
-		return getError(errAPI, errVectorSize)
+		return getError(errAPI, vectorSizeError(size))
 	}
 	C.duckdb_data_chunk_set_size(chunk.data, C.idx_t(size))
 	return nil
@@ -127,6 +139,17 @@ func (chunk *DataChunk) initFromDuckDataChunk(data C.duckdb_data_chunk, writable
 	return err
 }
 
+// resetDuckDataChunk points the initialized columns to the vectors of the next data chunk of a result.
+// Unlike initFromDuckDataChunk, it keeps the column state, e.g., ENUM dictionaries, across data chunks.
+func (chunk *DataChunk) resetDuckDataChunk(data C.duckdb_data_chunk, writable bool) {
+	chunk.data = data
+	for i := range chunk.columns {
+		duckdbVector := C.duckdb_data_chunk_get_vector(data, C.idx_t(i))
+		chunk.columns[i].initVectors(duckdbVector, writable)
+	}
+	chunk.GetSize()
+}
+
 func (chunk *DataChunk) initFromDuckVector(duckdbVector C.duckdb_vector, writable bool) error {
 	columnCount := 1
 	chunk.columns = make([]vector, columnCount)
@@ -0,0 +1,91 @@
+package duckdb
+
+/*
+#include <duckdb.h>
+*/
+import "C"
+
+import (
+	"context"
+	"database/sql/driver"
+	"errors"
+	"strings"
+)
+
+// ColumnDescription describes a result column of a query.
+type ColumnDescription struct {
+	// Name is the name of the column.
+	Name string
+	// Type is the internal type of the column.
+	Type Type
+	// TypeName is the full DuckDB type of the column, including its parameters, e.g., DECIMAL(18,4),
+	// as returned by sql.ColumnType.DatabaseTypeName.
+	TypeName string
+}
+
+// DescribeQuery returns the result columns of a SELECT query without executing it.
+// It plans the query with a LIMIT 0, which DuckDB answers without reading any data.
+// DescribeQuery binds NULL to all parameters of the query, so the types of columns,
+// which depend only on a parameter, e.g., SELECT ?, can differ from the types for actual arguments.
+// As DescribeQuery uses a new connection, the query cannot refer to temporary tables of other connections.
+func (c *Connector) DescribeQuery(ctx context.Context, query string) ([]ColumnDescription, error) {
+	driverConn, err := c.Connect(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer driverConn.Close()
+
+	columns, err := driverConn.(*conn).describeQuery(ctx, query)
+	if err != nil {
+		return nil, getError(errDescribeQuery, err)
+	}
+	return columns, nil
+}
+
+func (c *conn) describeQuery(ctx context.Context, query string) ([]ColumnDescription, error) {
+	// Ensure that the query is a single SELECT statement, so that we do not execute any other statements.
+	stmt, err := c.prepareStmt(query)
+	if err != nil {
+		return nil, err
+	}
+	stmtType := C.duckdb_prepared_statement_type(*stmt.stmt)
+	if err = stmt.Close(); err != nil {
+		return nil, err
+	}
+	if stmtType != C.DUCKDB_STATEMENT_TYPE_SELECT {
+		return nil, errDescribeNotSelect
+	}
+
+	// A parenthesized query keeps duplicate column names, but it fails, if the query already has a LIMIT clause.
+	// A subquery always works, but DuckDB renames its duplicate column names. The newlines end trailing comments.
+	query = strings.TrimRight(strings.TrimSpace(query), ";")
+	stmt, err = c.prepareStmt("(" + query + "\n) LIMIT 0")
+	if err != nil {
+		stmt, err = c.prepareStmt("SELECT * FROM (" + query + "\n) LIMIT 0")
+	}
+	if err != nil {
+		return nil, err
+	}
+	stmt.closeOnRowsClose = true
+
+	args := make([]driver.NamedValue, stmt.paramCount())
+	for i := range args {
+		args[i] = driver.NamedValue{Ordinal: i + 1}
+	}
+	res, err := stmt.QueryContext(ctx, args)
+	if err != nil {
+		return nil, errors.Join(err, stmt.Close())
+	}
+	r := res.(*rows)
+	defer r.Close()
+
+	columns := make([]ColumnDescription, len(r.Columns()))
+	for i, name := range r.Columns() {
+		columns[i] = ColumnDescription{
+			Name:     name,
+			Type:     Type(C.duckdb_column_type(&r.res, C.idx_t(i))),
+			TypeName: r.ColumnTypeDatabaseTypeName(i),
+		}
+	}
+	return columns, nil
+}
@@ -0,0 +1,71 @@
+package duckdb
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestDescribeQuery(t *testing.T) {
+	t.Parallel()
+	c, err := NewConnector("", nil)
+	require.NoError(t, err)
+	db := sql.OpenDB(c)
+	defer db.Close()
+
+	_, err = db.Exec(`CREATE TABLE t (id BIGINT, price DECIMAL(18, 4), tags VARCHAR[], info STRUCT(a INTEGER))`)
+	require.NoError(t, err)
+
+	queries := []string{
+		`SELECT * FROM t`,
+		`SELECT id, id AS id, price * 2 AS double_price FROM t WHERE id > ? -- comment`,
+		`SELECT id, count(*) AS n FROM t GROUP BY id ORDER BY id LIMIT 10;`,
+		`FROM t SELECT tags, info`,
+	}
+	for _, query := range queries {
+		columns, err := c.DescribeQuery(context.Background(), query)
+		require.NoError(t, err)
+
+		// The columns match the columns of the executed query.
+		rows, err := db.Query(query, 1)
+		require.NoError(t, err)
+		columnTypes, err := rows.ColumnTypes()
+		require.NoError(t, err)
+		require.Len(t, columns, len(columnTypes))
+		for i, columnType := range columnTypes {
+			require.Equal(t, columnType.Name(), columns[i].Name, query)
+			require.Equal(t, columnType.DatabaseTypeName(), columns[i].TypeName, query)
+		}
+		require.NoError(t, rows.Close())
+	}
+
+	columns, err := c.DescribeQuery(context.Background(), `SELECT * FROM t`)
+	require.NoError(t, err)
+	require.Equal(t, []ColumnDescription{
+		{Name: "id", Type: TYPE_BIGINT, TypeName: "BIGINT"},
+		{Name: "price", Type: TYPE_DECIMAL, TypeName: "DECIMAL(18,4)"},
+		{Name: "tags", Type: TYPE_LIST, TypeName: "VARCHAR[]"},
+		{Name: "info", Type: TYPE_STRUCT, TypeName: `STRUCT("a" INTEGER)`},
+	}, columns)
+
+	// DescribeQuery does not execute the query.
+	now := time.Now()
+	columns, err = c.DescribeQuery(context.Background(), `SELECT sum(t1.range * t2.range) AS s FROM range(100000000) t1, range(1000000) t2`)
+	require.NoError(t, err)
+	require.Equal(t, []ColumnDescription{{Name: "s", Type: TYPE_HUGEINT, TypeName: "HUGEINT"}}, columns)
+	require.Less(t, time.Since(now), 5*time.Second)
+
+	_, err = c.DescribeQuery(context.Background(), `INSERT INTO t (id) VALUES (1)`)
+	require.ErrorIs(t, err, errDescribeQuery)
+	require.ErrorIs(t, err, errDescribeNotSelect)
+	var count int
+	require.NoError(t, db.QueryRow(`SELECT count(*) FROM t`).Scan(&count))
+	require.Equal(t, 0, count)
+
+	_, err = c.DescribeQuery(context.Background(), `SELECT * FROM does_not_exist`)
+	require.ErrorIs(t, err, errDescribeQuery)
+	require.Equal(t, ErrorTypeCatalog, GetErrorType(err))
+}
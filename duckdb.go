@@ -13,9 +13,17 @@ import (
 	"context"
 	"database/sql"
 	"database/sql/driver"
+	"errors"
 	"fmt"
+	"io/fs"
 	"net/url"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
 	"strings"
+	"sync"
+	"time"
 	"unsafe"
 )
 
@@ -23,6 +31,18 @@ func init() {
 	sql.Register("duckdb", Driver{})
 }
 
+// Version returns the version of the linked DuckDB library, without the leading 'v'.
+// For DuckDB's development versions, the version contains a postfix.
+func Version() string {
+	return strings.TrimPrefix(C.GoString(C.duckdb_library_version()), "v")
+}
+
+// BindingsVersion returns the DuckDB version of the C API header that go-duckdb was built against.
+// It can differ from Version, if go-duckdb dynamically links DuckDB.
+func BindingsVersion() string {
+	return bindingsVersion
+}
+
 type Driver struct{}
 
 func (d Driver) Open(dsn string) (driver.Conn, error) {
@@ -39,42 +59,212 @@ func (Driver) OpenConnector(dsn string) (driver.Connector, error) {
 	})
 }
 
+// ConnectorOption sets an option when opening a Connector.
+// Configuration options take precedence over options with the same name in the DSN or the configuration map.
+type ConnectorOption func(opts *connectorOptions)
+
+type connectorOptions struct {
+	// config holds the DuckDB configuration options.
+	config map[string]string
+	// isolated is true, if the Connector opens its own database instance.
+	isolated bool
+	// queryTimeout is the default timeout of queries without a context deadline.
+	queryTimeout time.Duration
+	// queryLogger is called after executing each query.
+	queryLogger QueryLogger
+	// rejectNonFinite is true, if scanning NaN or infinite floats fails.
+	rejectNonFinite bool
+	// searchPath is the search_path setting of each new connection.
+	searchPath string
+}
+
+// WithThreads sets the number of threads DuckDB uses to execute queries.
+func WithThreads(n int) ConnectorOption {
+	return func(opts *connectorOptions) {
+		opts.config["threads"] = strconv.Itoa(n)
+	}
+}
+
+// WithMemoryLimit sets the maximum amount of memory DuckDB uses, e.g., "256MB" or "4GB".
+func WithMemoryLimit(limit string) ConnectorOption {
+	return func(opts *connectorOptions) {
+		opts.config["memory_limit"] = limit
+	}
+}
+
+// WithAccessMode sets the access mode of the database: "automatic", "read_only", or "read_write".
+// In the read_only mode, any write attempt fails with an error of type ErrorTypePermission.
+// DuckDB cannot open an in-memory database in the read_only mode.
+func WithAccessMode(mode string) ConnectorOption {
+	return func(opts *connectorOptions) {
+		opts.config["access_mode"] = mode
+	}
+}
+
+// WithTempDirectory sets the directory to which DuckDB spills data of queries exceeding the memory limit.
+// DuckDB creates the directory on the first spill, but its parent directory must exist.
+// An empty path disables spilling to disk.
+func WithTempDirectory(path string) ConnectorOption {
+	return func(opts *connectorOptions) {
+		opts.config["temp_directory"] = path
+	}
+}
+
+// WithMaxTempDirectorySize sets the maximum size of the temp directory, e.g., "10GB".
+// Queries that need to spill more data fail with an error of type ErrorTypeOutOfMemory.
+func WithMaxTempDirectorySize(size string) ConnectorOption {
+	return func(opts *connectorOptions) {
+		opts.config["max_temp_directory_size"] = size
+	}
+}
+
+// WithAutoloadExtensions sets both the autoinstall_known_extensions and the autoload_known_extensions option,
+// so that referencing a function, type, or file system of a known extension, e.g., httpfs, installs and loads it.
+// DuckDB enables both by default in most builds, so WithAutoloadExtensions(false) is the way to disable them.
+// WARNING: Autoinstalling downloads extensions from the internet, e.g., whenever a query references an https:// path.
+// Disable it, if queries are untrusted, or if the database must not access the network.
+func WithAutoloadExtensions(enabled bool) ConnectorOption {
+	return func(opts *connectorOptions) {
+		opts.config["autoinstall_known_extensions"] = strconv.FormatBool(enabled)
+		opts.config["autoload_known_extensions"] = strconv.FormatBool(enabled)
+	}
+}
+
+// WithIsolatedInstance makes the Connector open its own database instance,
+// instead of sharing the instance of other Connectors with the same path and configuration.
+// Use it to keep replacement scans and user-defined functions private to the Connector,
+// as these registrations apply to the whole database instance.
+func WithIsolatedInstance() ConnectorOption {
+	return func(opts *connectorOptions) {
+		opts.isolated = true
+	}
+}
+
+// WithQueryTimeout sets a default timeout for each query executed on a connection of the Connector.
+// If the context of a query has no deadline, then the query derives one from the timeout.
+// An explicit context deadline always takes precedence, even if it is later than the default timeout.
+// On expiry, DuckDB interrupts the query, which then fails with context.DeadlineExceeded.
+func WithQueryTimeout(d time.Duration) ConnectorOption {
+	return func(opts *connectorOptions) {
+		opts.queryTimeout = d
+	}
+}
+
+// QueryLogger is called after executing a query with the query text, its arguments,
+// the elapsed time, and the error of the query, if any. A failing query returns an *Error,
+// so errors.As(err, &duckdbErr) retrieves its ErrorType.
+type QueryLogger func(query string, args []driver.NamedValue, d time.Duration, err error)
+
+// WithQueryLogger sets a QueryLogger, which each connection of the Connector calls after executing a query
+// via ExecContext or QueryContext, including queries of prepared statements.
+// For a query returning rows, the elapsed time ends when the result is available, i.e., before scanning the rows.
+// The QueryLogger must be safe for concurrent use, as connections log their queries concurrently.
+func WithQueryLogger(logger QueryLogger) ConnectorOption {
+	return func(opts *connectorOptions) {
+		opts.queryLogger = logger
+	}
+}
+
+// WithRejectNonFinite makes scanning a NaN or an infinite FLOAT or DOUBLE value fail with an error of type
+// ErrorTypeConversion, if reject is true. By default, such values scan into their float counterparts.
+// It only checks FLOAT and DOUBLE columns, not floats nested in, e.g., LIST or STRUCT values.
+func WithRejectNonFinite(reject bool) ConnectorOption {
+	return func(opts *connectorOptions) {
+		opts.rejectNonFinite = reject
+	}
+}
+
+// WithSearchPath sets the search_path setting of each new connection, so that unqualified names resolve
+// to the tables of the given schemas, e.g., "tenant_a" or "tenant_a,main".
+// The schemas must exist when connecting, otherwise Connect returns an ErrorTypeCatalog error.
+func WithSearchPath(path string) ConnectorOption {
+	return func(opts *connectorOptions) {
+		opts.searchPath = path
+	}
+}
+
 // NewConnector opens a new Connector for a DuckDB database.
 // The user must close the Connector, if it is not passed to the sql.OpenDB function.
 // Otherwise, sql.DB closes the Connector when calling sql.DB.Close().
-func NewConnector(dsn string, connInitFn func(execer driver.ExecerContext) error) (*Connector, error) {
-	var db C.duckdb_database
-
+func NewConnector(dsn string, connInitFn func(execer driver.ExecerContext) error, opts ...ConnectorOption) (*Connector, error) {
 	parsedDSN, err := url.Parse(dsn)
 	if err != nil {
 		return nil, getError(errParseDSN, err)
 	}
 
-	config, err := prepareConfig(parsedDSN)
-	if err != nil {
-		return nil, err
+	config := make(map[string]string)
+	for k, v := range parsedDSN.Query() {
+		if len(v) == 0 {
+			continue
+		}
+		config[k] = v[0]
 	}
-	defer C.duckdb_destroy_config(&config)
 
-	connStr := C.CString(getConnString(dsn))
-	defer C.duckdb_free(unsafe.Pointer(connStr))
+	return NewConnectorWithConfig(getConnString(dsn), config, connInitFn, opts...)
+}
 
-	var outError *C.char
-	defer C.duckdb_free(unsafe.Pointer(outError))
+// NewConnectorWithConfig opens a new Connector for the DuckDB database at path.
+// Contrary to NewConnector, it takes the configuration options as key-value pairs instead of parsing them from a DSN.
+// Thus, the option values can contain characters like '?' or '&'.
+// The user must close the Connector, if it is not passed to the sql.OpenDB function.
+//
+// Connectors for the same database file and configuration share a single database instance,
+// unless opened WithIsolatedInstance. The instance closes when closing its last Connector.
+// In-memory databases are never shared. Registrations like replacement scans and user-defined functions
+// apply to the shared instance, so they are visible to all of its Connectors until the instance closes.
+func NewConnectorWithConfig(path string, config map[string]string, connInitFn func(execer driver.ExecerContext) error, opts ...ConnectorOption) (*Connector, error) {
+	options := connectorOptions{config: config}
+	if len(opts) != 0 {
+		// Do not modify the caller's map.
+		options.config = make(map[string]string, len(config)+len(opts))
+		for k, v := range config {
+			options.config[k] = v
+		}
+		for _, opt := range opts {
+			opt(&options)
+		}
+	}
 
-	if state := C.duckdb_open_ext(connStr, &db, config, &outError); state == C.DuckDBError {
-		return nil, getError(errOpen, duckdbError(outError))
+	var inst *instance
+	var err error
+	if key, ok := instanceKey(path, options.config); ok && !options.isolated {
+		inst, err = instances.acquire(key, path, options.config)
+	} else {
+		inst, err = openInstance(path, options.config)
+	}
+	if err != nil {
+		return nil, err
 	}
 
 	return &Connector{
-		db:         db,
-		connInitFn: connInitFn,
+		db:              inst.db,
+		inst:            inst,
+		connInitFn:      connInitFn,
+		queryTimeout:    options.queryTimeout,
+		queryLogger:     options.queryLogger,
+		rejectNonFinite: options.rejectNonFinite,
+		searchPath:      options.searchPath,
 	}, nil
 }
 
+// Connector opens connections to a DuckDB database. It is safe for concurrent use by multiple goroutines,
+// including Connectors sharing a database instance. A single connection is not safe for concurrent use.
 type Connector struct {
 	db         C.duckdb_database
+	inst       *instance
 	connInitFn func(execer driver.ExecerContext) error
+	// queryTimeout is the default timeout of queries without a context deadline. Zero disables it.
+	queryTimeout time.Duration
+	// queryLogger is called after executing each query. Nil disables it.
+	queryLogger QueryLogger
+	// rejectNonFinite is true, if scanning NaN or infinite floats fails.
+	rejectNonFinite bool
+	// searchPath is the search_path setting of each new connection. Empty keeps the default.
+	searchPath string
+
+	// mu protects conns, which are the open connections of the Connector.
+	mu    sync.Mutex
+	conns map[*conn]struct{}
 }
 
 func (*Connector) Driver() driver.Driver {
@@ -87,7 +277,18 @@ func (c *Connector) Connect(context.Context) (driver.Conn, error) {
 		return nil, getError(errConnect, nil)
 	}
 
-	con := &conn{duckdbCon: duckdbCon}
+	con := &conn{
+		duckdbCon:       duckdbCon,
+		queryTimeout:    c.queryTimeout,
+		queryLogger:     c.queryLogger,
+		rejectNonFinite: c.rejectNonFinite,
+	}
+
+	if c.searchPath != "" {
+		if _, err := con.execContext(context.Background(), "SET search_path = "+quoteString(c.searchPath), nil); err != nil {
+			return nil, getError(errConnect, errors.Join(err, con.Close()))
+		}
+	}
 
 	if c.connInitFn != nil {
 		if err := c.connInitFn(con); err != nil {
@@ -95,11 +296,60 @@ func (c *Connector) Connect(context.Context) (driver.Conn, error) {
 		}
 	}
 
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.conns == nil {
+		c.conns = make(map[*conn]struct{})
+	}
+	c.conns[con] = struct{}{}
+	con.connector = c
 	return con, nil
 }
 
+// IsInMemory returns true, if the Connector opened an in-memory database, i.e., its path is empty or ':memory:'.
+// File-only operations like Checkpoint fail for in-memory databases.
+// It reports the database of the Connector, not attached databases. It returns false after closing the Connector.
+func (c *Connector) IsInMemory() bool {
+	return c.inst != nil && c.inst.inMemory
+}
+
+// Interrupt interrupts the running queries of all open connections of the Connector, e.g., during a graceful shutdown.
+// The interrupted queries fail with an error of type ErrorTypeInterrupt.
+// It is safe to call Interrupt concurrently with running, finishing, and closing connections.
+func (c *Connector) Interrupt() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for con := range c.conns {
+		C.duckdb_interrupt(con.duckdbCon)
+	}
+}
+
+// removeConn removes a closing connection from the open connections of the Connector.
+func (c *Connector) removeConn(con *conn) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	delete(c.conns, con)
+}
+
+// ExecScript executes a SQL script of semicolon-separated statements on a new connection, e.g., a migration file.
+// It executes the statements in order and returns the result of the last statement.
+// If a statement fails, then the returned error contains its zero-based index in the script,
+// and ExecScript does not execute the remaining statements.
+func (c *Connector) ExecScript(ctx context.Context, script string) (driver.Result, error) {
+	driverConn, err := c.Connect(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer driverConn.Close()
+	return driverConn.(*conn).execScript(ctx, script)
+}
+
 func (c *Connector) Close() error {
-	C.duckdb_close(&c.db)
+	if c.inst == nil {
+		return nil
+	}
+	instances.release(c.inst)
+	c.inst = nil
 	c.db = nil
 	return nil
 }
@@ -112,7 +362,7 @@ func getConnString(dsn string) string {
 	return dsn[0:idx]
 }
 
-func prepareConfig(parsedDSN *url.URL) (C.duckdb_config, error) {
+func prepareConfig(options map[string]string) (C.duckdb_config, error) {
 	var config C.duckdb_config
 	if state := C.duckdb_create_config(&config); state == C.DuckDBError {
 		C.duckdb_destroy_config(&config)
@@ -123,16 +373,21 @@ func prepareConfig(parsedDSN *url.URL) (C.duckdb_config, error) {
 		return nil, err
 	}
 
-	// Early-out, if the DSN does not contain configuration options.
-	if len(parsedDSN.RawQuery) == 0 {
-		return config, nil
+	// Set the options in a deterministic order.
+	keys := make([]string, 0, len(options))
+	for k := range options {
+		keys = append(keys, k)
 	}
+	sort.Strings(keys)
 
-	for k, v := range parsedDSN.Query() {
-		if len(v) == 0 {
-			continue
+	for _, k := range keys {
+		if k == "temp_directory" {
+			if err := checkTempDirectory(options[k]); err != nil {
+				C.duckdb_destroy_config(&config)
+				return nil, getError(errSetConfig, fmt.Errorf("%s=%s: %w", k, options[k], err))
+			}
 		}
-		if err := setConfigOption(config, k, v[0]); err != nil {
+		if err := setConfigOption(config, k, options[k]); err != nil {
 			return nil, err
 		}
 	}
@@ -140,6 +395,34 @@ func prepareConfig(parsedDSN *url.URL) (C.duckdb_config, error) {
 	return config, nil
 }
 
+// checkTempDirectory fails fast on temp directories that DuckDB cannot create,
+// instead of failing the first query that spills to disk.
+func checkTempDirectory(path string) error {
+	if path == "" {
+		return nil
+	}
+	info, err := os.Stat(path)
+	if err == nil {
+		if !info.IsDir() {
+			return errors.New("not a directory")
+		}
+		return nil
+	}
+	if !errors.Is(err, fs.ErrNotExist) {
+		return err
+	}
+
+	// DuckDB creates the directory, but not its parents.
+	info, err = os.Stat(filepath.Dir(path))
+	if err != nil {
+		return err
+	}
+	if !info.IsDir() {
+		return errors.New("parent is not a directory")
+	}
+	return nil
+}
+
 func setConfigOption(config C.duckdb_config, name string, option string) error {
 	cName := C.CString(name)
 	defer C.duckdb_free(unsafe.Pointer(cName))
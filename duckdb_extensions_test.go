@@ -4,6 +4,9 @@ package duckdb
 
 import (
 	"database/sql"
+	"encoding/binary"
+	"math"
+	"reflect"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -21,3 +24,105 @@ func TestOpenSQLite(t *testing.T) {
 	require.Equal(t, "Gopher", species)
 	require.NoError(t, db.Close())
 }
+
+func TestLoadExtension(t *testing.T) {
+	t.Parallel()
+
+	c, err := NewConnector("", nil)
+	require.NoError(t, err)
+	db := sql.OpenDB(c)
+
+	// The json and parquet extensions are bundled with the static library.
+	require.NoError(t, c.LoadExtension("json"))
+	require.NoError(t, c.LoadExtension("parquet"))
+
+	var valid bool
+	require.NoError(t, db.QueryRow(`SELECT json_valid('{"duck": 42}')`).Scan(&valid))
+	require.True(t, valid)
+
+	err = c.LoadExtension("not_exist")
+	testError(t, err, errLoadExtension.Error())
+	require.Equal(t, ErrorTypeIO, GetErrorType(err))
+
+	err = c.LoadExtension("")
+	testError(t, err, errLoadExtension.Error(), errEmptyName.Error())
+	err = c.InstallExtension("")
+	testError(t, err, errInstallExtension.Error(), errEmptyName.Error())
+
+	require.NoError(t, db.Close())
+	require.NoError(t, c.Close())
+}
+
+func TestLoadedExtensions(t *testing.T) {
+	t.Parallel()
+
+	c, err := NewConnector("", nil)
+	require.NoError(t, err)
+
+	extensions, err := c.LoadedExtensions()
+	require.NoError(t, err)
+
+	byName := map[string]ExtensionInfo{}
+	for _, ext := range extensions {
+		byName[ext.Name] = ext
+	}
+
+	// The json and parquet extensions are bundled with the static library.
+	for _, name := range []string{"json", "parquet"} {
+		ext, ok := byName[name]
+		require.True(t, ok, name)
+		require.True(t, ext.Loaded, name)
+		require.True(t, ext.Installed, name)
+		require.NotEmpty(t, ext.Version, name)
+		require.Equal(t, "(BUILT-IN)", ext.InstallPath, name)
+	}
+
+	// duckdb_extensions() also lists known extensions, which are not loaded.
+	require.Contains(t, byName, "httpfs")
+
+	require.NoError(t, c.Close())
+	_, err = c.LoadedExtensions()
+	testError(t, err, errConnect.Error())
+}
+
+func TestGeometry(t *testing.T) {
+	t.Parallel()
+
+	c, err := NewConnector("", nil)
+	require.NoError(t, err)
+	db := sql.OpenDB(c)
+	defer db.Close()
+
+	// The spatial extension is not bundled, so it requires network access to install it.
+	if err = c.LoadExtension("spatial"); err != nil {
+		if err = c.InstallExtension("spatial"); err == nil {
+			err = c.LoadExtension("spatial")
+		}
+	}
+	if err != nil {
+		t.Skipf("spatial extension is not available: %v", err)
+	}
+
+	rows, err := db.Query(`SELECT ST_Point(1, 2) AS g, ST_AsWKB(ST_Point(1, 2)) AS wkb, ST_AsText(ST_Point(1, 2)) AS wkt`)
+	require.NoError(t, err)
+	defer rows.Close()
+
+	types, err := rows.ColumnTypes()
+	require.NoError(t, err)
+	require.Equal(t, "GEOMETRY", types[0].DatabaseTypeName())
+	require.Equal(t, reflect.TypeOf([]byte{}), types[0].ScanType())
+
+	require.True(t, rows.Next())
+	var g, wkb []byte
+	var wkt string
+	require.NoError(t, rows.Scan(&g, &wkb, &wkt))
+	require.NotEmpty(t, g)
+
+	// A little-endian WKB point with the coordinates 1 and 2.
+	expected := []byte{0x01, 0x01, 0x00, 0x00, 0x00}
+	expected = binary.LittleEndian.AppendUint64(expected, math.Float64bits(1))
+	expected = binary.LittleEndian.AppendUint64(expected, math.Float64bits(2))
+	require.Equal(t, expected, wkb)
+	require.Equal(t, "POINT (1 2)", wkt)
+	require.NoError(t, rows.Err())
+}
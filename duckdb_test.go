@@ -7,9 +7,13 @@ import (
 	"encoding/json"
 	"fmt"
 	"log"
+	"math"
 	"math/big"
 	"os"
+	"path/filepath"
 	"reflect"
+	"strings"
+	"sync"
 	"testing"
 	"time"
 
@@ -41,6 +45,23 @@ func TestOpen(t *testing.T) {
 	})
 }
 
+func TestVersion(t *testing.T) {
+	require.NotEmpty(t, Version())
+	require.False(t, strings.HasPrefix(Version(), "v"))
+
+	// The Makefile generates the bindings version, see make deps.version.
+	// It can differ from Version, if go-duckdb dynamically links another DuckDB version.
+	makefile, err := os.ReadFile("Makefile")
+	require.NoError(t, err)
+	require.Contains(t, string(makefile), "\nDUCKDB_BRANCH=v"+BindingsVersion()+"\n")
+
+	db := openDB(t)
+	var version string
+	require.NoError(t, db.QueryRow(`SELECT version()`).Scan(&version))
+	require.Equal(t, "v"+Version(), version)
+	require.NoError(t, db.Close())
+}
+
 func TestConnectorBootQueries(t *testing.T) {
 	t.Run("readme example", func(t *testing.T) {
 		db, err := sql.Open("duckdb", "foo.db")
@@ -68,6 +89,27 @@ func TestConnectorBootQueries(t *testing.T) {
 	})
 }
 
+func TestNewConnectorWithConfig(t *testing.T) {
+	dir := t.TempDir()
+	path := dir + "/with?special&chars.db"
+
+	c, err := NewConnectorWithConfig(path, map[string]string{
+		"threads":     "3",
+		"access_mode": "read_write",
+	}, nil)
+	require.NoError(t, err)
+
+	db := sql.OpenDB(c)
+	var threads int64
+	require.NoError(t, db.QueryRow(`SELECT current_setting('threads')`).Scan(&threads))
+	require.Equal(t, int64(3), threads)
+
+	var file string
+	require.NoError(t, db.QueryRow(`SELECT path FROM duckdb_databases() WHERE database_name = current_database()`).Scan(&file))
+	require.Equal(t, path, file)
+	require.NoError(t, db.Close())
+}
+
 func TestConnector_Close(t *testing.T) {
 	t.Parallel()
 
@@ -164,13 +206,12 @@ func TestConnInit(t *testing.T) {
 
 	res, err := conn1.ExecContext(context.Background(), "CREATE TABLE example (j JSON)")
 	require.NoError(t, err)
-	ra, err := res.RowsAffected()
-	require.NoError(t, err)
-	require.Equal(t, int64(0), ra)
+	_, err = res.RowsAffected()
+	require.ErrorIs(t, err, ErrRowsAffectedNotSupported)
 
 	res, err = conn2.ExecContext(context.Background(), "INSERT INTO example VALUES(' { \"family\": \"anatidae\", \"species\": [ \"duck\", \"goose\", \"swan\", null ] }')")
 	require.NoError(t, err)
-	ra, err = res.RowsAffected()
+	ra, err := res.RowsAffected()
 	require.NoError(t, err)
 	require.Equal(t, int64(1), ra)
 
@@ -192,6 +233,77 @@ func TestConnInit(t *testing.T) {
 	require.NoError(t, err)
 }
 
+func TestPing(t *testing.T) {
+	db := openDB(t)
+	defer db.Close()
+	require.NoError(t, db.PingContext(context.Background()))
+
+	con, err := db.Conn(context.Background())
+	require.NoError(t, err)
+	defer con.Close()
+	require.NoError(t, con.PingContext(context.Background()))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	require.ErrorIs(t, con.PingContext(ctx), context.Canceled)
+
+	// A closed driver connection reports driver.ErrBadConn.
+	err = con.Raw(func(driverConn any) error {
+		c := driverConn.(*conn)
+		require.NoError(t, c.Close())
+		return c.Ping(context.Background())
+	})
+	require.ErrorIs(t, err, driver.ErrBadConn)
+}
+
+func TestBadConn(t *testing.T) {
+	db := openDB(t)
+	defer db.Close()
+
+	var driverConns []*conn
+	useConn := func(f func(c *conn) error) error {
+		con, err := db.Conn(context.Background())
+		require.NoError(t, err)
+		defer con.Close()
+		return con.Raw(func(driverConn any) error {
+			c := driverConn.(*conn)
+			driverConns = append(driverConns, c)
+			return f(c)
+		})
+	}
+
+	// Other errors keep the connection in the pool.
+	err := useConn(func(c *conn) error {
+		_, err := c.ExecContext(context.Background(), "SELEC 1", nil)
+		return err
+	})
+	require.ErrorIs(t, err, ErrParser)
+	require.NoError(t, useConn(func(*conn) error { return nil }))
+	require.Same(t, driverConns[0], driverConns[1])
+	require.Equal(t, 1, db.Stats().OpenConnections)
+
+	// Simulate a fatal error, as DuckDB has no statement to cause one. The caller receives the DuckDB error,
+	// and the pool discards the connection.
+	fatal := &Error{Type: ErrorTypeFatal, Msg: "FATAL Error: simulated"}
+	err = useConn(func(c *conn) error {
+		return c.checkError(fatal)
+	})
+	require.Equal(t, ErrorTypeFatal, GetErrorType(err))
+	require.True(t, driverConns[2].bad)
+	require.False(t, driverConns[2].IsValid())
+	require.ErrorIs(t, driverConns[2].Ping(context.Background()), driver.ErrBadConn)
+	require.Equal(t, 0, db.Stats().OpenConnections)
+
+	require.NoError(t, useConn(func(*conn) error { return nil }))
+	require.NotSame(t, driverConns[2], driverConns[3])
+
+	// Internal errors also invalidate the connection.
+	require.Error(t, useConn(func(c *conn) error {
+		return c.checkError(&Error{Type: ErrorTypeInternal, Msg: "INTERNAL Error: simulated"})
+	}))
+	require.Equal(t, 0, db.Stats().OpenConnections)
+}
+
 func TestExec(t *testing.T) {
 	t.Parallel()
 	db := openDB(t)
@@ -199,6 +311,569 @@ func TestExec(t *testing.T) {
 	require.NotNil(t, createFooTable(db, t))
 }
 
+func TestColumnTypeDatabaseTypeName(t *testing.T) {
+	t.Parallel()
+	db := openDB(t)
+	defer db.Close()
+
+	_, err := db.Exec(`CREATE TYPE money AS DECIMAL(18,4)`)
+	require.NoError(t, err)
+	_, err = db.Exec(`CREATE TYPE mood AS ENUM ('happy', 'sad')`)
+	require.NoError(t, err)
+	_, err = db.Exec(`CREATE TABLE tbl (
+		d DECIMAL(18,4),
+		m money,
+		e mood,
+		l DECIMAL(9,2)[],
+		a INTEGER[3],
+		s STRUCT(price DECIMAL(4,1), moods mood[]),
+		mp MAP(VARCHAR, DECIMAL(38,10))
+	)`)
+	require.NoError(t, err)
+
+	res, err := db.Query(`SELECT * FROM tbl`)
+	require.NoError(t, err)
+	defer res.Close()
+
+	cols, err := res.ColumnTypes()
+	require.NoError(t, err)
+
+	// DuckDB resolves the aliases of user-defined types in query results.
+	expected := []string{
+		"DECIMAL(18,4)",
+		"DECIMAL(18,4)",
+		"ENUM",
+		"DECIMAL(9,2)[]",
+		"INTEGER[3]",
+		`STRUCT("price" DECIMAL(4,1), "moods" ENUM[])`,
+		"MAP(VARCHAR, DECIMAL(38,10))",
+	}
+	for i, name := range expected {
+		require.Equal(t, name, cols[i].DatabaseTypeName())
+	}
+
+	precision, scale, ok := cols[0].DecimalSize()
+	require.True(t, ok)
+	require.Equal(t, int64(18), precision)
+	require.Equal(t, int64(4), scale)
+	precision, scale, ok = cols[1].DecimalSize()
+	require.True(t, ok)
+	require.Equal(t, int64(18), precision)
+	require.Equal(t, int64(4), scale)
+	_, _, ok = cols[3].DecimalSize()
+	require.False(t, ok)
+
+	width, decimalScale, err := ParseDecimalTypeName(cols[0].DatabaseTypeName())
+	require.NoError(t, err)
+	require.Equal(t, uint8(18), width)
+	require.Equal(t, uint8(4), decimalScale)
+}
+
+func TestConnectorOptions(t *testing.T) {
+	t.Parallel()
+	config := map[string]string{"threads": "1"}
+	c, err := NewConnectorWithConfig("", config, nil, WithThreads(2), WithMemoryLimit("256MB"))
+	require.NoError(t, err)
+	require.Equal(t, map[string]string{"threads": "1"}, config)
+	db := sql.OpenDB(c)
+
+	var threads int
+	require.NoError(t, db.QueryRow(`SELECT current_setting('threads')`).Scan(&threads))
+	require.Equal(t, 2, threads)
+
+	var memoryLimit, expected string
+	require.NoError(t, db.QueryRow(`SELECT current_setting('memory_limit')`).Scan(&memoryLimit))
+	require.NoError(t, db.QueryRow(`SELECT format_bytes(256 * 1000 * 1000)`).Scan(&expected))
+	require.Equal(t, expected, memoryLimit)
+	require.NoError(t, db.Close())
+}
+
+func TestConnectorAutoloadExtensions(t *testing.T) {
+	t.Parallel()
+	open := func(enabled bool) *sql.DB {
+		c, err := NewConnector("", nil, WithAutoloadExtensions(enabled))
+		require.NoError(t, err)
+		db := sql.OpenDB(c)
+
+		var autoinstall, autoload bool
+		require.NoError(t, db.QueryRow(`SELECT current_setting('autoinstall_known_extensions'),
+			current_setting('autoload_known_extensions')`).Scan(&autoinstall, &autoload))
+		require.Equal(t, enabled, autoinstall)
+		require.Equal(t, enabled, autoload)
+		return db
+	}
+
+	// dbgen is a function of the tpch extension.
+	db := open(false)
+	_, err := db.Exec(`CALL dbgen(sf = 0)`)
+	require.Equal(t, ErrorTypeCatalog, GetErrorType(err))
+	require.ErrorContains(t, err, "tpch")
+	require.NoError(t, db.Close())
+
+	db = open(true)
+	if _, err = db.Exec(`CALL dbgen(sf = 0)`); err != nil {
+		// Autoinstalling the extension fails without network access.
+		require.Equal(t, ErrorTypeAutoLoad, GetErrorType(err))
+	} else {
+		var loaded bool
+		require.NoError(t, db.QueryRow(`SELECT loaded FROM duckdb_extensions() WHERE extension_name = 'tpch'`).Scan(&loaded))
+		require.True(t, loaded)
+	}
+	require.NoError(t, db.Close())
+}
+
+func TestConnectorTempDirectory(t *testing.T) {
+	t.Parallel()
+	dir := filepath.Join(t.TempDir(), "spill")
+	c, err := NewConnector("", nil, WithTempDirectory(dir), WithMaxTempDirectorySize("1GB"),
+		WithMemoryLimit("32MB"), WithThreads(1))
+	require.NoError(t, err)
+	db := sql.OpenDB(c)
+
+	var tempDir, maxSize, expected string
+	require.NoError(t, db.QueryRow(`SELECT current_setting('temp_directory')`).Scan(&tempDir))
+	require.Equal(t, dir, tempDir)
+	require.NoError(t, db.QueryRow(`SELECT current_setting('max_temp_directory_size')`).Scan(&maxSize))
+	require.NoError(t, db.QueryRow(`SELECT format_bytes(1000 * 1000 * 1000)`).Scan(&expected))
+	require.Equal(t, expected, maxSize)
+
+	// A large sort exceeds the memory limit, so DuckDB spills to the temp directory.
+	_, err = db.Exec(`CREATE TABLE sorted AS SELECT i, i::VARCHAR AS s FROM range(3000000) t(i) ORDER BY hash(i)`)
+	require.NoError(t, err)
+	var path string
+	require.NoError(t, db.QueryRow(`SELECT path FROM duckdb_temporary_files() LIMIT 1`).Scan(&path))
+	require.Equal(t, dir, filepath.Dir(path))
+	require.NoError(t, db.Close())
+
+	// Invalid paths fail when opening the Connector.
+	file := filepath.Join(t.TempDir(), "file")
+	require.NoError(t, os.WriteFile(file, nil, 0o600))
+	_, err = NewConnector("", nil, WithTempDirectory(file))
+	testError(t, err, errSetConfig.Error(), "not a directory")
+	_, err = NewConnector("", nil, WithTempDirectory(filepath.Join(t.TempDir(), "missing", "spill")))
+	testError(t, err, errSetConfig.Error(), "temp_directory=")
+	_, err = NewConnector("", nil, WithMaxTempDirectorySize("lots"))
+	testError(t, err, errSetConfig.Error(), "max_temp_directory_size=lots")
+}
+
+func TestSharedInstance(t *testing.T) {
+	t.Parallel()
+	path := t.TempDir() + "/shared.db"
+
+	c1, err := NewConnector(path, nil)
+	require.NoError(t, err)
+	c2, err := NewConnector(path, nil)
+	require.NoError(t, err)
+	require.Equal(t, c1.db, c2.db)
+
+	db1 := sql.OpenDB(c1)
+	db2 := sql.OpenDB(c2)
+	_, err = db1.Exec(`CREATE TABLE tbl (i INTEGER); INSERT INTO tbl VALUES (42)`)
+	require.NoError(t, err)
+
+	var i int
+	require.NoError(t, db2.QueryRow(`SELECT i FROM tbl`).Scan(&i))
+	require.Equal(t, 42, i)
+
+	// The instance stays open until closing its last Connector.
+	require.NoError(t, db1.Close())
+	require.NoError(t, db2.QueryRow(`SELECT i FROM tbl`).Scan(&i))
+	require.Equal(t, 42, i)
+
+	// In-memory databases and connectors opened WithIsolatedInstance are not shared.
+	m1, err := NewConnector("", nil)
+	require.NoError(t, err)
+	m2, err := NewConnector("", nil)
+	require.NoError(t, err)
+	require.NotEqual(t, m1.db, m2.db)
+	require.NoError(t, m1.Close())
+	require.NoError(t, m2.Close())
+
+	require.NoError(t, db2.Close())
+	c3, err := NewConnector(path, nil, WithIsolatedInstance())
+	require.NoError(t, err)
+	c4, err := NewConnector(path, nil)
+	require.NoError(t, err)
+	require.NotEqual(t, c3.db, c4.db)
+	require.NoError(t, c4.Close())
+
+	require.NoError(t, sql.OpenDB(c3).QueryRow(`SELECT i FROM tbl`).Scan(&i))
+	require.Equal(t, 42, i)
+	require.NoError(t, c3.Close())
+}
+
+func TestIsolatedInstanceRegistrations(t *testing.T) {
+	path := t.TempDir() + "/registrations.db"
+
+	c1, err := NewConnector(path, nil)
+	require.NoError(t, err)
+	c2, err := NewConnector(path, nil)
+	require.NoError(t, err)
+	c3, err := NewConnector(path, nil, WithIsolatedInstance())
+	require.NoError(t, err)
+
+	// Register a replacement scan and a scalar UDF via the first Connector.
+	RegisterReplacementScan(c1, func(tableName string) (string, []any, error) {
+		return "range", []any{int64(3)}, nil
+	})
+	db1 := sql.OpenDB(c1)
+	con, err := db1.Conn(context.Background())
+	require.NoError(t, err)
+	currentInfo, err = NewTypeInfo(TYPE_INTEGER)
+	require.NoError(t, err)
+	var udf *constantSUDF
+	require.NoError(t, RegisterScalarUDF(con, "constant_one", udf))
+	require.NoError(t, con.Close())
+
+	// They apply to the shared instance, and they outlive closing the Connector that registered them.
+	require.NoError(t, db1.Close())
+	db2 := sql.OpenDB(c2)
+	var count, one int
+	require.NoError(t, db2.QueryRow(`SELECT count(*) FROM any_table`).Scan(&count))
+	require.Equal(t, 3, count)
+	require.NoError(t, db2.QueryRow(`SELECT constant_one()`).Scan(&one))
+	require.Equal(t, 1, one)
+	require.NoError(t, db2.Close())
+
+	// A Connector opened WithIsolatedInstance does not see them.
+	db3 := sql.OpenDB(c3)
+	err = db3.QueryRow(`SELECT count(*) FROM any_table`).Scan(&count)
+	require.Equal(t, ErrorTypeCatalog, GetErrorType(err))
+	err = db3.QueryRow(`SELECT constant_one()`).Scan(&one)
+	require.Equal(t, ErrorTypeCatalog, GetErrorType(err))
+	require.NoError(t, db3.Close())
+}
+
+func TestAccessMode(t *testing.T) {
+	t.Parallel()
+	path := t.TempDir() + "/read_only.db"
+
+	db, err := sql.Open("duckdb", path)
+	require.NoError(t, err)
+	_, err = db.Exec(`CREATE TABLE tbl (i INTEGER); INSERT INTO tbl VALUES (1)`)
+	require.NoError(t, err)
+	require.NoError(t, db.Close())
+
+	c, err := NewConnector(path, nil, WithAccessMode("read_only"))
+	require.NoError(t, err)
+	db = sql.OpenDB(c)
+
+	var i int
+	require.NoError(t, db.QueryRow(`SELECT i FROM tbl`).Scan(&i))
+	require.Equal(t, 1, i)
+
+	_, err = db.Exec(`INSERT INTO tbl VALUES (2)`)
+	require.Error(t, err)
+	require.Equal(t, ErrorTypePermission, GetErrorType(err))
+	require.NoError(t, db.Close())
+
+	_, err = NewConnector("", nil, WithAccessMode("read_only"))
+	require.Error(t, err)
+}
+
+func TestReadOnlyTx(t *testing.T) {
+	t.Parallel()
+	db := openDB(t)
+	_, err := db.Exec(`CREATE TABLE tbl (i INTEGER)`)
+	require.NoError(t, err)
+
+	tx, err := db.BeginTx(context.Background(), &sql.TxOptions{ReadOnly: true, Isolation: sql.LevelSnapshot})
+	require.NoError(t, err)
+	_, err = tx.Exec(`INSERT INTO tbl VALUES (1)`)
+	require.Error(t, err)
+	require.Equal(t, ErrorTypePermission, GetErrorType(err))
+	require.NoError(t, tx.Rollback())
+
+	_, err = db.BeginTx(context.Background(), &sql.TxOptions{Isolation: sql.LevelSerializable})
+	require.Error(t, err)
+	require.NoError(t, db.Close())
+}
+
+func TestWithRetry(t *testing.T) {
+	t.Parallel()
+	db := openDB(t)
+	ctx := context.Background()
+	_, err := db.Exec(`CREATE TABLE tbl (id INTEGER PRIMARY KEY, v INTEGER); INSERT INTO tbl VALUES (1, 0)`)
+	require.NoError(t, err)
+
+	// A concurrent transaction updates the same row, so that the first attempt conflicts.
+	other, err := db.BeginTx(ctx, nil)
+	require.NoError(t, err)
+	_, err = other.Exec(`UPDATE tbl SET v = v + 1 WHERE id = 1`)
+	require.NoError(t, err)
+
+	attempts := 0
+	err = WithRetry(ctx, db, 3, func(tx *sql.Tx) error {
+		attempts++
+		_, err := tx.Exec(`UPDATE tbl SET v = v + 10 WHERE id = 1`)
+		if attempts == 1 {
+			require.ErrorIs(t, err, ErrTransactionConflict)
+			require.NoError(t, other.Commit())
+		}
+		return err
+	})
+	require.NoError(t, err)
+	require.Equal(t, 2, attempts)
+
+	var v int
+	require.NoError(t, db.QueryRow(`SELECT v FROM tbl WHERE id = 1`).Scan(&v))
+	require.Equal(t, 11, v)
+
+	// Constraint violations are not retried, and roll back the transaction.
+	attempts = 0
+	err = WithRetry(ctx, db, 3, func(tx *sql.Tx) error {
+		attempts++
+		if _, err := tx.Exec(`UPDATE tbl SET v = 0 WHERE id = 1`); err != nil {
+			return err
+		}
+		_, err := tx.Exec(`INSERT INTO tbl VALUES (1, 1)`)
+		return err
+	})
+	require.ErrorIs(t, err, ErrConstraintViolation)
+	require.Equal(t, 1, attempts)
+	require.NoError(t, db.QueryRow(`SELECT v FROM tbl WHERE id = 1`).Scan(&v))
+	require.Equal(t, 11, v)
+
+	// WithRetry returns the last conflict after maxAttempts attempts.
+	attempts = 0
+	err = WithRetry(ctx, db, 3, func(tx *sql.Tx) error {
+		attempts++
+		return &Error{Type: ErrorTypeSerialization, Msg: "Serialization Error: test conflict"}
+	})
+	require.Equal(t, ErrorTypeSerialization, GetErrorType(err))
+	require.Equal(t, 3, attempts)
+
+	// A done context stops retrying.
+	cancelCtx, cancel := context.WithCancel(ctx)
+	attempts = 0
+	err = WithRetry(cancelCtx, db, 3, func(tx *sql.Tx) error {
+		attempts++
+		cancel()
+		return &Error{Type: ErrorTypeTransaction, Msg: "TransactionContext Error: test conflict"}
+	})
+	require.ErrorIs(t, err, ErrTransactionConflict)
+	require.ErrorIs(t, err, context.Canceled)
+	require.Equal(t, 1, attempts)
+	require.NoError(t, db.Close())
+}
+
+func TestExecScript(t *testing.T) {
+	t.Parallel()
+	c, err := NewConnector("", nil)
+	require.NoError(t, err)
+	db := sql.OpenDB(c)
+
+	res, err := c.ExecScript(context.Background(), `
+		CREATE TABLE migrations (id INTEGER PRIMARY KEY, name VARCHAR);
+		INSERT INTO migrations VALUES (1, 'create');
+		INSERT INTO migrations VALUES (2, 'alter'), (3, 'drop');
+	`)
+	require.NoError(t, err)
+	ra, err := res.RowsAffected()
+	require.NoError(t, err)
+	require.Equal(t, int64(2), ra)
+
+	// The third statement violates the primary key.
+	_, err = c.ExecScript(context.Background(), `
+		INSERT INTO migrations VALUES (4, 'index');
+		UPDATE migrations SET name = 'create table' WHERE id = 1;
+		INSERT INTO migrations VALUES (1, 'duplicate');
+		INSERT INTO migrations VALUES (5, 'never');
+	`)
+	testError(t, err, errExecScript.Error(), stmtIndexErrMsg+": 2")
+	require.Equal(t, ErrorTypeConstraint, GetErrorType(err))
+
+	var count int
+	require.NoError(t, db.QueryRow(`SELECT COUNT(*) FROM migrations`).Scan(&count))
+	require.Equal(t, 4, count)
+
+	_, err = c.ExecScript(context.Background(), `SELECT 42; SELEC 43;`)
+	testError(t, err, errExecScript.Error())
+	require.Equal(t, ErrorTypeParser, GetErrorType(err))
+
+	require.NoError(t, db.Close())
+}
+
+func TestNextResultSet(t *testing.T) {
+	t.Parallel()
+	db := openDB(t)
+	defer db.Close()
+
+	t.Run("SELECT 1; SELECT 2;", func(t *testing.T) {
+		res, err := db.Query(`SELECT 1; SELECT 2;`)
+		require.NoError(t, err)
+
+		var v int
+		for _, expected := range []int{1, 2} {
+			require.True(t, res.Next())
+			require.NoError(t, res.Scan(&v))
+			require.Equal(t, expected, v)
+			require.False(t, res.Next())
+			if expected == 1 {
+				require.True(t, res.NextResultSet())
+			}
+		}
+		require.False(t, res.NextResultSet())
+		require.NoError(t, res.Err())
+		require.NoError(t, res.Close())
+	})
+
+	t.Run("skip statements without rows", func(t *testing.T) {
+		res, err := db.Query(`CREATE TABLE tbl (i INTEGER); SELECT 'a' AS s; INSERT INTO tbl VALUES (42); SELECT i FROM tbl`)
+		require.NoError(t, err)
+
+		var s string
+		require.True(t, res.Next())
+		require.NoError(t, res.Scan(&s))
+		require.Equal(t, "a", s)
+
+		// Skip the remaining rows of the current result set.
+		require.True(t, res.NextResultSet())
+		var i int
+		require.True(t, res.Next())
+		require.NoError(t, res.Scan(&i))
+		require.Equal(t, 42, i)
+		require.False(t, res.NextResultSet())
+		require.NoError(t, res.Close())
+	})
+
+	t.Run("error in later statement", func(t *testing.T) {
+		res, err := db.Query(`SELECT 1; SELECT * FROM does_not_exist; SELECT 3`)
+		require.NoError(t, err)
+
+		var v int
+		require.True(t, res.Next())
+		require.NoError(t, res.Scan(&v))
+		require.Equal(t, 1, v)
+
+		require.False(t, res.NextResultSet())
+		require.Equal(t, ErrorTypeCatalog, GetErrorType(res.Err()))
+		require.NoError(t, res.Close())
+
+		// The connection is still usable.
+		require.NoError(t, db.QueryRow(`SELECT 4`).Scan(&v))
+		require.Equal(t, 4, v)
+	})
+
+	t.Run("close executes trailing statements", func(t *testing.T) {
+		_, err := db.Exec(`CREATE TABLE trail (i INTEGER)`)
+		require.NoError(t, err)
+
+		// Closing the rows executes the remaining statements, except for SELECT statements.
+		res, err := db.Query(`SELECT 1; INSERT INTO trail VALUES (1); SELECT 2; INSERT INTO trail VALUES (?)`, 2)
+		require.NoError(t, err)
+		require.NoError(t, res.Close())
+
+		// QueryRow reads the first result set.
+		var v int
+		require.NoError(t, db.QueryRow(`SELECT 3; INSERT INTO trail VALUES (3)`).Scan(&v))
+		require.Equal(t, 3, v)
+
+		var sum int
+		require.NoError(t, db.QueryRow(`SELECT sum(i) FROM trail`).Scan(&sum))
+		require.Equal(t, 6, sum)
+
+		// Close returns the error of a trailing statement.
+		res, err = db.Query(`SELECT 1; INSERT INTO does_not_exist VALUES (1)`)
+		require.NoError(t, err)
+		require.Equal(t, ErrorTypeCatalog, GetErrorType(res.Close()))
+	})
+}
+
+func TestExecRowsAffected(t *testing.T) {
+	t.Parallel()
+	db := openDB(t)
+	defer db.Close()
+
+	res, err := db.Exec(`CREATE TABLE tbl (id INTEGER, val VARCHAR)`)
+	require.NoError(t, err)
+	_, err = res.RowsAffected()
+	require.ErrorIs(t, err, ErrRowsAffectedNotSupported)
+
+	res, err = db.Exec(`INSERT INTO tbl SELECT range, 'a' FROM range(5)`)
+	require.NoError(t, err)
+	ra, err := res.RowsAffected()
+	require.NoError(t, err)
+	require.Equal(t, int64(5), ra)
+
+	res, err = db.Exec(`UPDATE tbl SET val = 'b' WHERE id = ?`, 2)
+	require.NoError(t, err)
+	ra, err = res.RowsAffected()
+	require.NoError(t, err)
+	require.Equal(t, int64(1), ra)
+
+	res, err = db.Exec(`UPDATE tbl SET val = 'c' WHERE id >= ?`, 2)
+	require.NoError(t, err)
+	ra, err = res.RowsAffected()
+	require.NoError(t, err)
+	require.Equal(t, int64(3), ra)
+
+	res, err = db.Exec(`UPDATE tbl SET val = 'd' WHERE id > 100`)
+	require.NoError(t, err)
+	ra, err = res.RowsAffected()
+	require.NoError(t, err)
+	require.Equal(t, int64(0), ra)
+
+	res, err = db.Exec(`DELETE FROM tbl WHERE id < 2`)
+	require.NoError(t, err)
+	ra, err = res.RowsAffected()
+	require.NoError(t, err)
+	require.Equal(t, int64(2), ra)
+}
+
+func TestReturning(t *testing.T) {
+	t.Parallel()
+	db := openDB(t)
+	defer db.Close()
+
+	_, err := db.Exec(`CREATE SEQUENCE seq START 10; CREATE TABLE tbl (id INTEGER DEFAULT nextval('seq'), val VARCHAR)`)
+	require.NoError(t, err)
+
+	// Query returns the generated IDs.
+	res, err := db.Query(`INSERT INTO tbl (val) VALUES (?), (?), (?) RETURNING id, val`, "a", "b", "c")
+	require.NoError(t, err)
+	var ids []int32
+	var vals []string
+	for res.Next() {
+		var id int32
+		var val string
+		require.NoError(t, res.Scan(&id, &val))
+		ids = append(ids, id)
+		vals = append(vals, val)
+	}
+	require.NoError(t, res.Err())
+	require.NoError(t, res.Close())
+	require.Equal(t, []int32{10, 11, 12}, ids)
+	require.Equal(t, []string{"a", "b", "c"}, vals)
+
+	var id int32
+	require.NoError(t, db.QueryRow(`INSERT INTO tbl (val) VALUES ('d') RETURNING id`).Scan(&id))
+	require.Equal(t, int32(13), id)
+
+	// Exec discards the returned rows, but reports the number of changed rows.
+	r, err := db.Exec(`INSERT INTO tbl (val) VALUES ('e'), ('f') RETURNING id`)
+	require.NoError(t, err)
+	ra, err := r.RowsAffected()
+	require.NoError(t, err)
+	require.Equal(t, int64(2), ra)
+
+	r, err = db.Exec(`UPDATE tbl SET val = 'x' WHERE id < ? RETURNING *`, 12)
+	require.NoError(t, err)
+	ra, err = r.RowsAffected()
+	require.NoError(t, err)
+	require.Equal(t, int64(2), ra)
+
+	r, err = db.Exec(`DELETE FROM tbl WHERE id > 100 RETURNING id`)
+	require.NoError(t, err)
+	ra, err = r.RowsAffected()
+	require.NoError(t, err)
+	require.Equal(t, int64(0), ra)
+
+	var count int
+	require.NoError(t, db.QueryRow(`SELECT count(*) FROM tbl`).Scan(&count))
+	require.Equal(t, 6, count)
+}
+
 func TestQuery(t *testing.T) {
 	t.Parallel()
 	db := openDB(t)
@@ -297,6 +972,69 @@ func TestJSON(t *testing.T) {
 		require.Equal(t, items, []string{"foo", "bar"})
 	})
 
+	t.Run("scan into JSON", func(t *testing.T) {
+		_, err := db.Exec(`CREATE TABLE docs (id INTEGER, doc JSON)`)
+		require.NoError(t, err)
+		_, err = db.Exec(`INSERT INTO docs VALUES
+			(1, '{"name": "duck", "tags": ["a", "b"], "nested": {"n": 42}}'),
+			(2, 'null'),
+			(3, NULL)`)
+		require.NoError(t, err)
+
+		rows, err := db.Query(`SELECT doc FROM docs`)
+		require.NoError(t, err)
+		types, err := rows.ColumnTypes()
+		require.NoError(t, err)
+		require.Equal(t, "JSON", types[0].DatabaseTypeName())
+		require.NoError(t, rows.Close())
+
+		// Scan into a map.
+		var m JSON[map[string]any]
+		require.NoError(t, db.QueryRow(`SELECT doc FROM docs WHERE id = 1`).Scan(&m))
+		require.Equal(t, map[string]any{
+			"name":   "duck",
+			"tags":   []any{"a", "b"},
+			"nested": map[string]any{"n": float64(42)},
+		}, m.Get())
+
+		// Scan into a typed struct.
+		type doc struct {
+			Name   string   `json:"name"`
+			Tags   []string `json:"tags"`
+			Nested *struct {
+				N int `json:"n"`
+			} `json:"nested"`
+		}
+		var d JSON[doc]
+		require.NoError(t, db.QueryRow(`SELECT doc FROM docs WHERE id = 1`).Scan(&d))
+		require.Equal(t, "duck", d.Get().Name)
+		require.Equal(t, []string{"a", "b"}, d.Get().Tags)
+		require.Equal(t, 42, d.Get().Nested.N)
+		require.Error(t, db.QueryRow(`SELECT '[1, 2]'::JSON`).Scan(&d))
+
+		// A JSON null and a SQL NULL result in nil.
+		var p JSON[*doc]
+		require.NoError(t, db.QueryRow(`SELECT doc FROM docs WHERE id = 2`).Scan(&p))
+		require.Nil(t, p.Get())
+		require.NoError(t, db.QueryRow(`SELECT doc FROM docs WHERE id = 1`).Scan(&m))
+		require.NoError(t, db.QueryRow(`SELECT doc FROM docs WHERE id = 3`).Scan(&m))
+		require.Nil(t, m.Get())
+
+		// Scanning into a string keeps the raw JSON.
+		var raw string
+		require.NoError(t, db.QueryRow(`SELECT doc FROM docs WHERE id = 2`).Scan(&raw))
+		require.Equal(t, "null", raw)
+	})
+
+	t.Run("bind JSON", func(t *testing.T) {
+		type doc struct {
+			Name string `json:"name"`
+		}
+		var name string
+		require.NoError(t, db.QueryRow(`SELECT ?::JSON->>'name'`, NewJSON(doc{Name: "goose"})).Scan(&name))
+		require.Equal(t, "goose", name)
+	})
+
 	require.NoError(t, db.Close())
 }
 
@@ -509,6 +1247,51 @@ func TestTypeNamesAndScanTypes(t *testing.T) {
 	require.NoError(t, db.Close())
 }
 
+func TestNullScanTypes(t *testing.T) {
+	db := openDB(t)
+	defer db.Close()
+
+	query := `SELECT 1::BIGINT AS i, 1.5::DOUBLE AS d, 'a' AS s, TIMESTAMP '1992-09-20 11:30:00' AS ts, 2::TINYINT AS ti,
+		1.5::DECIMAL(4, 1) AS dec, [1] AS l, 'a'::BLOB AS b
+		UNION ALL SELECT NULL, NULL, NULL, NULL, NULL, NULL, NULL, NULL`
+	expected := []reflect.Type{
+		reflect.TypeOf(int64(0)), reflect.TypeOf(float64(0)), reflect.TypeOf(""), reflect.TypeOf(time.Time{}),
+		reflect.TypeOf(int8(0)), reflect.TypeOf(Decimal{}), reflect.TypeOf([]any{}), reflect.TypeOf([]byte{}),
+	}
+	expectedNull := []reflect.Type{
+		reflect.TypeOf(sql.NullInt64{}), reflect.TypeOf(sql.NullFloat64{}), reflect.TypeOf(sql.NullString{}),
+		reflect.TypeOf(sql.NullTime{}), reflect.TypeOf((*int8)(nil)), reflect.TypeOf((*Decimal)(nil)),
+		reflect.TypeOf((*[]any)(nil)), reflect.TypeOf([]byte{}),
+	}
+
+	scanTypes := func(ctx context.Context, expected []reflect.Type, nullable bool) {
+		rows, err := db.QueryContext(ctx, query)
+		require.NoError(t, err)
+		defer rows.Close()
+
+		cols, err := rows.ColumnTypes()
+		require.NoError(t, err)
+		dest := make([]any, len(cols))
+		for i, col := range cols {
+			require.Equal(t, expected[i], col.ScanType(), col.Name())
+			dest[i] = reflect.New(col.ScanType()).Interface()
+		}
+
+		// The scan types hold the values of the first row, and the NULL scan types also those of the second row.
+		require.True(t, rows.Next())
+		require.NoError(t, rows.Scan(dest...))
+		require.True(t, rows.Next())
+		if nullable {
+			require.NoError(t, rows.Scan(dest...))
+		} else {
+			require.Error(t, rows.Scan(dest...))
+		}
+	}
+
+	scanTypes(context.Background(), expected, false)
+	scanTypes(WithNullScanTypes(context.Background()), expectedNull, true)
+}
+
 // Running multiple statements in a single query. All statements except the last one are executed and if no error then last statement is executed with args and result returned.
 func TestMultipleStatements(t *testing.T) {
 	db := openDB(t)
@@ -575,18 +1358,24 @@ func TestMultipleStatements(t *testing.T) {
 	// Trying select with ExecContext also works but we don't get the result
 	res, err = conn.ExecContext(context.Background(), "CREATE TABLE foo3(bar VARCHAR, baz INTEGER); INSERT INTO foo3 VALUES ('lala', 12345); select bar from foo3 limit 1")
 	require.NoError(t, err)
-	ra, err = res.RowsAffected()
-	require.NoError(t, err)
-	require.Equal(t, int64(0), ra)
+	_, err = res.RowsAffected()
+	require.ErrorIs(t, err, ErrRowsAffectedNotSupported)
 
-	// multiple selects, but we get results only for the last one
+	// multiple selects, each select yields a result set, and Next reads the first one (previously the last one)
 	rows, err = conn.QueryContext(context.Background(), "INSERT INTO foo3 VALUES ('lalo', 1234); select bar from foo3 where baz=12345; select bar from foo3 where baz=$1", 1234)
 	require.NoError(t, err)
 	require.True(t, rows.Next())
 	err = rows.Scan(&bar)
 	require.NoError(t, err)
+	require.Equal(t, "lala", bar)
+	require.False(t, rows.Next())
+	require.True(t, rows.NextResultSet())
+	require.True(t, rows.Next())
+	err = rows.Scan(&bar)
+	require.NoError(t, err)
 	require.Equal(t, "lalo", bar)
 	require.False(t, rows.Next())
+	require.False(t, rows.NextResultSet())
 	err = rows.Close()
 	require.NoError(t, err)
 
@@ -652,6 +1441,222 @@ func TestQueryTimeout(t *testing.T) {
 	require.NoError(t, db.Close())
 }
 
+func TestQueryCancel(t *testing.T) {
+	db := openDB(t)
+	con, err := db.Conn(context.Background())
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	go func() {
+		time.Sleep(time.Millisecond * 250)
+		cancel()
+	}()
+
+	now := time.Now()
+	_, err = con.QueryContext(ctx, "SELECT SUM(t1.range * t2.range) FROM range(10000000) t1, range(1000000) t2")
+	require.ErrorIs(t, err, context.Canceled)
+	require.Equal(t, ErrorTypeInterrupt, GetErrorType(err))
+	require.Less(t, time.Since(now), 10*time.Second)
+
+	// The connection is still usable after the interrupt.
+	var res int
+	require.NoError(t, con.QueryRowContext(context.Background(), "SELECT 42").Scan(&res))
+	require.Equal(t, 42, res)
+
+	require.NoError(t, con.Close())
+	require.NoError(t, db.Close())
+}
+
+func TestConnectorQueryTimeout(t *testing.T) {
+	c, err := NewConnector("", nil, WithQueryTimeout(100*time.Millisecond))
+	require.NoError(t, err)
+	db := sql.OpenDB(c)
+
+	const slowQuery = `WITH RECURSIVE t(i) AS (SELECT 1 UNION ALL SELECT i + 1 FROM t WHERE i < 1000000000)
+		SELECT count(*) FROM t`
+
+	now := time.Now()
+	_, err = db.Exec(slowQuery)
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+	require.Equal(t, ErrorTypeInterrupt, GetErrorType(err))
+	require.Less(t, time.Since(now), 10*time.Second)
+
+	// An explicit context deadline takes precedence over the default timeout.
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	now = time.Now()
+	_, err = db.ExecContext(ctx, slowQuery)
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+	require.GreaterOrEqual(t, time.Since(now), time.Second)
+
+	// Fast queries are unaffected.
+	var res int
+	require.NoError(t, db.QueryRow(`SELECT 42`).Scan(&res))
+	require.Equal(t, 42, res)
+	require.NoError(t, db.Close())
+}
+
+func TestConnectorQueryLogger(t *testing.T) {
+	type logEntry struct {
+		query string
+		args  []driver.NamedValue
+		d     time.Duration
+		err   error
+	}
+	var mu sync.Mutex
+	var entries []logEntry
+	logger := func(query string, args []driver.NamedValue, d time.Duration, err error) {
+		mu.Lock()
+		defer mu.Unlock()
+		entries = append(entries, logEntry{query, args, d, err})
+	}
+
+	c, err := NewConnector("", nil, WithQueryLogger(logger))
+	require.NoError(t, err)
+	db := sql.OpenDB(c)
+
+	_, err = db.Exec(`CREATE TABLE logged (i INTEGER)`)
+	require.NoError(t, err)
+	var res int
+	require.NoError(t, db.QueryRow(`SELECT ?::INTEGER`, 42).Scan(&res))
+	require.Equal(t, 42, res)
+
+	stmt, err := db.Prepare(`INSERT INTO logged VALUES (?)`)
+	require.NoError(t, err)
+	_, err = stmt.Exec(1)
+	require.NoError(t, err)
+	require.NoError(t, stmt.Close())
+
+	_, err = db.Exec(`SELECT * FROM does_not_exist`)
+	require.Error(t, err)
+	require.NoError(t, db.Close())
+
+	require.Len(t, entries, 4)
+	require.Equal(t, `CREATE TABLE logged (i INTEGER)`, entries[0].query)
+	require.Equal(t, `SELECT ?::INTEGER`, entries[1].query)
+	require.Equal(t, []driver.NamedValue{{Ordinal: 1, Value: int64(42)}}, entries[1].args)
+	require.Equal(t, `INSERT INTO logged VALUES (?)`, entries[2].query)
+	require.Equal(t, `SELECT * FROM does_not_exist`, entries[3].query)
+	for _, e := range entries {
+		require.GreaterOrEqual(t, e.d, time.Duration(0))
+	}
+	for _, e := range entries[:3] {
+		require.NoError(t, e.err)
+	}
+
+	var duckdbErr *Error
+	require.ErrorAs(t, entries[3].err, &duckdbErr)
+	require.Equal(t, ErrorTypeCatalog, duckdbErr.Type)
+}
+
+func TestConnectorRejectNonFinite(t *testing.T) {
+	t.Parallel()
+	const query = `SELECT 'nan'::DOUBLE, 'inf'::FLOAT, '-inf'::DOUBLE`
+
+	// By default, non-finite values scan into floats.
+	db := openDB(t)
+	var nan, negInf float64
+	var inf float32
+	require.NoError(t, db.QueryRow(query).Scan(&nan, &inf, &negInf))
+	require.True(t, math.IsNaN(nan))
+	require.True(t, math.IsInf(float64(inf), 1))
+	require.True(t, math.IsInf(negInf, -1))
+	require.NoError(t, db.Close())
+
+	c, err := NewConnector("", nil, WithRejectNonFinite(true))
+	require.NoError(t, err)
+	db = sql.OpenDB(c)
+
+	for _, q := range []string{`SELECT 'nan'::DOUBLE AS d`, `SELECT 'inf'::FLOAT AS d`, `SELECT '-inf'::DOUBLE AS d`} {
+		var d float64
+		err = db.QueryRow(q).Scan(&d)
+		require.ErrorIs(t, err, ErrConversion)
+		require.ErrorContains(t, err, `non-finite value`)
+		require.ErrorContains(t, err, `column "d"`)
+	}
+
+	// Finite values and NULL values scan as usual.
+	var finite float64
+	var null *float64
+	require.NoError(t, db.QueryRow(`SELECT 1.5::DOUBLE, NULL::DOUBLE`).Scan(&finite, &null))
+	require.Equal(t, 1.5, finite)
+	require.Nil(t, null)
+	require.NoError(t, db.Close())
+}
+
+func TestConnectorSearchPath(t *testing.T) {
+	t.Parallel()
+	path := filepath.Join(t.TempDir(), "tenants.db")
+
+	c, err := NewConnector(path, nil)
+	require.NoError(t, err)
+	db := sql.OpenDB(c)
+	_, err = db.Exec(`CREATE SCHEMA tenant_a;
+		CREATE TABLE tenant_a.users (name VARCHAR);
+		INSERT INTO tenant_a.users VALUES ('alice')`)
+	require.NoError(t, err)
+	require.NoError(t, db.Close())
+
+	// Unqualified names resolve to the tables of the schema.
+	c, err = NewConnector(path, nil, WithSearchPath("tenant_a"))
+	require.NoError(t, err)
+	db = sql.OpenDB(c)
+	var name string
+	require.NoError(t, db.QueryRow(`SELECT name FROM users`).Scan(&name))
+	require.Equal(t, "alice", name)
+	_, err = db.Exec(`CREATE TABLE orders (id INTEGER)`)
+	require.NoError(t, err)
+	var schema string
+	require.NoError(t, db.QueryRow(`SELECT schema_name FROM duckdb_tables() WHERE table_name = 'orders'`).Scan(&schema))
+	require.Equal(t, "tenant_a", schema)
+	require.NoError(t, db.Close())
+
+	// A missing schema fails when connecting.
+	c, err = NewConnector("", nil, WithSearchPath("missing"))
+	require.NoError(t, err)
+	_, err = c.Connect(context.Background())
+	testError(t, err, errConnect.Error())
+	require.Equal(t, ErrorTypeCatalog, GetErrorType(err))
+	require.NoError(t, c.Close())
+}
+
+func TestConnectorInterrupt(t *testing.T) {
+	c, err := NewConnector("", nil)
+	require.NoError(t, err)
+	db := sql.OpenDB(c)
+
+	// Interrupting a Connector without running queries is a no-op.
+	c.Interrupt()
+
+	errCh := make(chan error, 2)
+	for i := 0; i < 2; i++ {
+		go func() {
+			_, err := db.Exec("SELECT SUM(t1.range * t2.range) FROM range(10000000) t1, range(1000000) t2")
+			errCh <- err
+		}()
+	}
+
+	time.Sleep(time.Millisecond * 250)
+	now := time.Now()
+	c.Interrupt()
+	for i := 0; i < 2; i++ {
+		err = <-errCh
+		require.Error(t, err)
+		require.Equal(t, ErrorTypeInterrupt, GetErrorType(err))
+	}
+	require.Less(t, time.Since(now), 10*time.Second)
+
+	// The connections are still usable after the interrupt.
+	var res int
+	require.NoError(t, db.QueryRow("SELECT 42").Scan(&res))
+	require.Equal(t, 42, res)
+
+	require.NoError(t, db.Close())
+	c.mu.Lock()
+	require.Empty(t, c.conns)
+	c.mu.Unlock()
+}
+
 func Example_simpleConnection() {
 	// Connect to DuckDB using '[database/sql.Open]'.
 	db, err := sql.Open("duckdb", "?access_mode=READ_WRITE")
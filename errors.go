@@ -11,13 +11,24 @@ func getError(errDriver error, err error) error {
 	if err == nil {
 		return fmt.Errorf("%s: %w", driverErrMsg, errDriver)
 	}
-	return fmt.Errorf("%s: %w: %s", driverErrMsg, errDriver, err.Error())
+	return fmt.Errorf("%s: %w: %w", driverErrMsg, errDriver, err)
 }
 
 func duckdbError(err *C.char) error {
 	return fmt.Errorf("%s: %w", duckdbErrMsg, errors.New(C.GoString(err)))
 }
 
+// appenderError classifies the error of a failed append, as DuckDB's appender errors lack the error type prefix,
+// e.g., a NOT NULL constraint failure is a constraint error.
+func appenderError(err *C.char) error {
+	msg := C.GoString(err)
+	errType := getErrorTypeFromMsg(msg)
+	if errType == ErrorTypeInvalid && strings.Contains(strings.ToLower(msg), "constraint") {
+		errType = ErrorTypeConstraint
+	}
+	return fmt.Errorf("%s: %w", duckdbErrMsg, &Error{Type: errType, Msg: msg})
+}
+
 func castError(actual string, expected string) error {
 	return fmt.Errorf("%s: cannot cast %s to %s", castErrMsg, actual, expected)
 }
@@ -26,10 +37,44 @@ func structFieldError(actual string, expected string) error {
 	return fmt.Errorf("%s: expected %s, got %s", structFieldErrMsg, expected, actual)
 }
 
+func unexpectedStructFieldError(name string) error {
+	return fmt.Errorf("%s: unexpected field %s", structFieldErrMsg, name)
+}
+
+func unionTagError(tag string) error {
+	return fmt.Errorf("%s: unknown member %q", unionTagErrMsg, tag)
+}
+
+// appenderCreationError classifies the error as a catalog error,
+// as creating an appender only fails if DuckDB cannot find its table.
+func appenderCreationError(err *C.char) error {
+	return fmt.Errorf("%s: %w", duckdbErrMsg, &Error{Type: ErrorTypeCatalog, Msg: C.GoString(err)})
+}
+
+func decimalError(msg string) error {
+	return &Error{Type: ErrorTypeDecimal, Msg: msg}
+}
+
+func unsupportedMapKeyTypeError(name string) error {
+	return fmt.Errorf("%w: %s", errUnsupportedMapKeyType, name)
+}
+
+func outOfRangeError(msg string) error {
+	return &Error{Type: ErrorTypeOutOfRange, Msg: msg}
+}
+
+func scriptStmtError(err error, idx int) error {
+	return fmt.Errorf("%s: %d: %w", stmtIndexErrMsg, idx, err)
+}
+
 func columnCountError(actual int, expected int) error {
 	return fmt.Errorf("%s: expected %d, got %d", columnCountErrMsg, expected, actual)
 }
 
+func vectorSizeError(size int) error {
+	return fmt.Errorf("%w: requested %d rows, vector size is %d", errVectorSize, size, GetDataChunkCapacity())
+}
+
 func unsupportedTypeError(name string) error {
 	return fmt.Errorf("%s: %s", unsupportedTypeErrMsg, name)
 }
@@ -49,10 +94,30 @@ func addIndexToError(err error, idx int) error {
 	return fmt.Errorf("%w: %s: %d", err, indexErrMsg, idx)
 }
 
+func columnError(err error, idx int) error {
+	return fmt.Errorf("%w: %s: %d", err, columnIndexErrMsg, idx)
+}
+
+func structFieldNameError(err error, name string) error {
+	return fmt.Errorf("%w: %s: %s", err, fieldErrMsg, name)
+}
+
+func paramNotFoundError(name string) error {
+	return fmt.Errorf("%w: %s", errParamNotFound, name)
+}
+
 func interfaceIsNilError(interfaceName string) error {
 	return fmt.Errorf("%s: %s", interfaceIsNilErrMsg, interfaceName)
 }
 
+func generatedColumnsError(names []string) error {
+	return fmt.Errorf("%w: %s", errAppenderGenerated, strings.Join(names, ", "))
+}
+
+func unknownCopyFormatError(name string) error {
+	return fmt.Errorf("%w: %s", errUnknownCopyFormat, name)
+}
+
 func duplicateNameError(name string) error {
 	return fmt.Errorf("%s: %s", duplicateNameErrMsg, name)
 }
@@ -62,16 +127,24 @@ const (
 	duckdbErrMsg           = "duckdb error"
 	castErrMsg             = "cast error"
 	structFieldErrMsg      = "invalid STRUCT field"
+	unionTagErrMsg         = "invalid UNION tag"
 	columnCountErrMsg      = "invalid column count"
 	unsupportedTypeErrMsg  = "unsupported data type"
 	invalidatedAppenderMsg = "appended data has been invalidated due to corrupt row"
 	tryOtherFuncErrMsg     = "please try this function instead"
 	indexErrMsg            = "index"
+	stmtIndexErrMsg        = "statement index"
+	columnIndexErrMsg      = "column index"
+	fieldErrMsg            = "field"
 	unknownTypeErrMsg      = "unknown type"
 	interfaceIsNilErrMsg   = "interface is nil"
 	duplicateNameErrMsg    = "duplicate name"
 )
 
+// ErrRowsAffectedNotSupported is returned by RowsAffected, if DuckDB does not report
+// the number of changed rows for the executed statement, e.g., for DDL statements.
+var ErrRowsAffectedNotSupported = errors.New("rows affected not supported for this statement")
+
 var (
 	errInternal   = errors.New("internal error: please file a bug report at go-duckdb")
 	errAPI        = errors.New("API error")
@@ -82,6 +155,12 @@ var (
 	errSetConfig  = errors.New("could not set invalid or local option for global database config")
 	errInvalidCon = errors.New("not a DuckDB driver connection")
 	errClosedCon  = errors.New("closed connection")
+	errClosedStmt = errors.New("closed statement")
+
+	errUnresolvedParamType = errors.New("could not resolve the parameter type")
+	errParamNotFound       = errors.New("parameter not found")
+	errMixedParams         = errors.New("cannot mix named and positional parameters")
+	errExecScript          = errors.New("could not execute script")
 
 	errAppenderCreation         = errors.New("could not create appender")
 	errAppenderClose            = errors.New("could not close appender")
@@ -89,12 +168,19 @@ var (
 	errAppenderAppendRow        = errors.New("could not append row")
 	errAppenderAppendAfterClose = fmt.Errorf("%w: appender already closed", errAppenderAppendRow)
 	errAppenderFlush            = errors.New("could not flush appender")
+	errAppenderNoDefault        = errors.New("column has no DEFAULT value")
+	errAppenderGenerated        = errors.New("cannot append to GENERATED columns")
+
+	errAppenderAppendAfterFlushErr = fmt.Errorf("%w: appender invalidated by a previous error, please close it", errAppenderAppendRow)
 
 	errUnsupportedMapKeyType = errors.New("MAP key type not supported")
+	errMapParamString        = errors.New("cannot represent the string in a MAP parameter")
 	errEmptyName             = errors.New("empty name")
 	errInvalidDecimalWidth   = fmt.Errorf("the DECIMAL with must be between 1 and %d", max_decimal_width)
 	errInvalidDecimalScale   = errors.New("the DECIMAL scale must be less than or equal to the width")
+	errParseDecimalTypeName  = errors.New("could not parse DECIMAL type name")
 	errSetSQLNULLValue       = errors.New("cannot write to a NULL column")
+	errInvalidBitstring      = errors.New("invalid BIT string")
 
 	errScalarUDFCreate          = errors.New("could not create scalar UDF")
 	errScalarUDFNoName          = fmt.Errorf("%w: missing name", errScalarUDFCreate)
@@ -106,14 +192,65 @@ var (
 	errScalarUDFCreateSet       = fmt.Errorf("could not create scalar UDF set")
 	errScalarUDFAddToSet        = fmt.Errorf("%w: could not add the function to the set", errScalarUDFCreateSet)
 
+	errAggregateUDFCreate          = errors.New("could not create aggregate UDF")
+	errAggregateUDFNoName          = fmt.Errorf("%w: missing name", errAggregateUDFCreate)
+	errAggregateUDFIsNil           = fmt.Errorf("%w: function is nil", errAggregateUDFCreate)
+	errAggregateUDFNoExecutor      = fmt.Errorf("%w: executor is incomplete", errAggregateUDFCreate)
+	errAggregateUDFInputTypeIsNil  = fmt.Errorf("%w: input type is nil", errAggregateUDFCreate)
+	errAggregateUDFResultTypeIsNil = fmt.Errorf("%w: result type is nil", errAggregateUDFCreate)
+	errAggregateUDFResultTypeIsANY = fmt.Errorf("%w: result type is ANY, which is not supported", errAggregateUDFCreate)
+
 	errTableUDFCreate          = errors.New("could not create table UDF")
 	errTableUDFNoName          = fmt.Errorf("%w: missing name", errTableUDFCreate)
 	errTableUDFMissingBindArgs = fmt.Errorf("%w: missing bind arguments", errTableUDFCreate)
 	errTableUDFArgumentIsNil   = fmt.Errorf("%w: argument is nil", errTableUDFCreate)
 	errTableUDFColumnTypeIsNil = fmt.Errorf("%w: column type is nil", errTableUDFCreate)
 
+	errReplacementTableNotFound = errors.New("could not find the source of the replacement table")
+
 	errProfilingInfoEmpty = errors.New("no profiling information available for this connection")
 
+	errGetSetting = errors.New("could not get setting")
+	errSetSetting = errors.New("could not set setting")
+	errSetSeed    = errors.New("could not set seed")
+
+	errSelect         = errors.New("could not select into destination")
+	errSelectDest     = fmt.Errorf("%w: destination must be a pointer to a slice of structs or struct pointers", errSelect)
+	errSelectNoField  = fmt.Errorf("%w: no field for column", errSelect)
+	errSelectDupField = fmt.Errorf("%w: duplicate field for column", errSelect)
+
+	errScanStructList     = errors.New("could not scan list of structs")
+	errScanStructListType = fmt.Errorf("%w: element type must be a struct", errScanStructList)
+	errScanStructListNull = fmt.Errorf("%w: NULL element", errScanStructList)
+	errScanStructListCol  = fmt.Errorf("%w: column out of range", errScanStructList)
+
+	errPragma    = errors.New("could not execute pragma")
+	errSetPragma = errors.New("could not set pragma")
+
+	errAttach = errors.New("could not attach database")
+	errDetach = errors.New("could not detach database")
+
+	errDescribeQuery     = errors.New("could not describe query")
+	errDescribeNotSelect = errors.New("only SELECT statements can be described")
+
+	errEstimateRows = errors.New("could not estimate rows")
+
+	errCopyFrom          = errors.New("could not copy from file")
+	errExportQuery       = errors.New("could not export query")
+	errUnknownCopyFormat = errors.New("unknown file format, please set the format")
+	errCopyCSVOptions    = errors.New("header and delimiter options require the csv format")
+
+	errCheckpoint         = errors.New("could not checkpoint database")
+	errCheckpointInMemory = errors.New("in-memory databases have no file to checkpoint")
+
+	errInstallExtension = errors.New("could not install extension")
+	errLoadExtension    = errors.New("could not load extension")
+	errExtensions       = errors.New("could not list extensions")
+	errMemoryUsage      = errors.New("could not get memory usage")
+
+	errCreateTable        = errors.New("could not create table")
+	errCreateTableOptions = errors.New("IfNotExists and OrReplace are mutually exclusive")
+
 	// Errors not covered in tests.
 	errConnect      = errors.New("could not connect to database")
 	errCreateConfig = errors.New("could not create config for database")
@@ -218,27 +355,110 @@ type Error struct {
 	Msg  string
 }
 
+// Sentinel errors of common error types. As they have an empty Msg, errors.Is matches any *Error of their type,
+// e.g., errors.Is(err, ErrConstraintViolation) for a PRIMARY KEY violation.
+var (
+	ErrOutOfRange          = &Error{Type: ErrorTypeOutOfRange}
+	ErrConversion          = &Error{Type: ErrorTypeConversion}
+	ErrDivideByZero        = &Error{Type: ErrorTypeDivideByZero}
+	ErrTransactionConflict = &Error{Type: ErrorTypeTransaction}
+	ErrCatalog             = &Error{Type: ErrorTypeCatalog}
+	ErrParser              = &Error{Type: ErrorTypeParser}
+	ErrConstraintViolation = &Error{Type: ErrorTypeConstraint}
+	ErrBinder              = &Error{Type: ErrorTypeBinder}
+	ErrIOError             = &Error{Type: ErrorTypeIO}
+	ErrInterrupted         = &Error{Type: ErrorTypeInterrupt}
+	ErrInvalidInput        = &Error{Type: ErrorTypeInvalidInput}
+	ErrOutOfMemory         = &Error{Type: ErrorTypeOutOfMemory}
+	ErrPermission          = &Error{Type: ErrorTypePermission}
+)
+
+// Error returns the error message. Without a message, e.g., for the sentinel errors,
+// it returns the DuckDB prefix of the error type, e.g., "Constraint Error".
 func (e *Error) Error() string {
+	if e.Msg == "" {
+		for prefix, typ := range errorPrefixMap {
+			if typ == e.Type {
+				return prefix
+			}
+		}
+	}
 	return e.Msg
 }
 
+// Is reports whether err matches e. If err has an empty Msg, then Is only compares the error types.
+// This allows matching all errors of a specific type, e.g., errors.Is(err, &Error{Type: ErrorTypeConstraint}).
+// Otherwise, Is compares the error messages.
 func (e *Error) Is(err error) bool {
 	if other, ok := err.(*Error); ok {
+		if other.Msg == "" {
+			return other.Type == e.Type
+		}
 		return other.Msg == e.Msg
 	}
 	return false
 }
 
+// GetErrorType returns the ErrorType of the first *Error in err's tree.
+// It returns ErrorTypeInvalid, if err does not wrap an *Error.
+func GetErrorType(err error) ErrorType {
+	var duckdbErr *Error
+	if errors.As(err, &duckdbErr) {
+		return duckdbErr.Type
+	}
+	return ErrorTypeInvalid
+}
+
 func getDuckDBError(errMsg string) error {
+	return &Error{
+		Type: getErrorTypeFromMsg(errMsg),
+		Msg:  errMsg,
+	}
+}
+
+// readOnlyErrMsgs are parts of the error messages of write attempts on read-only databases or transactions.
+// DuckDB reports these as invalid input and transaction context errors, but they lack permissions.
+var readOnlyErrMsgs = []string{
+	"which is attached in read-only mode",
+	"transaction is launched in read-only mode",
+}
+
+func getErrorTypeFromMsg(errMsg string) ErrorType {
+	for _, msg := range readOnlyErrMsgs {
+		if strings.Contains(errMsg, msg) {
+			return ErrorTypePermission
+		}
+	}
+
+	// Find the end of the prefix ("<error-type> Error: "). The colon is optional.
+	prefix := errMsg
+	if idx := strings.Index(errMsg, ":"); idx != -1 {
+		prefix = errMsg[:idx]
+	}
+	prefix = strings.TrimSpace(prefix)
+	for p, typ := range errorPrefixMap {
+		if strings.EqualFold(prefix, p) {
+			return typ
+		}
+	}
+
+	// Fall back to scanning the message for the first (and longest) known prefix.
+	// We skip the bare "Error" prefix, as it is contained in any other prefix.
+	lowerMsg := strings.ToLower(errMsg)
 	errType := ErrorTypeInvalid
-	// find the end of the prefix ("<error-type> Error: ")
-	if idx := strings.Index(errMsg, ": "); idx != -1 {
-		if typ, ok := errorPrefixMap[errMsg[:idx]]; ok {
+	pos, length := -1, 0
+	for p, typ := range errorPrefixMap {
+		if p == "Error" {
+			continue
+		}
+		idx := strings.Index(lowerMsg, strings.ToLower(p))
+		if idx == -1 {
+			continue
+		}
+		if pos == -1 || idx < pos || (idx == pos && len(p) > length) {
 			errType = typ
+			pos, length = idx, len(p)
 		}
 	}
-	return &Error{
-		Type: errType,
-		Msg:  errMsg,
-	}
+	return errType
 }
@@ -4,7 +4,10 @@ import (
 	"context"
 	"database/sql"
 	"database/sql/driver"
+	"encoding/json"
 	"errors"
+	"fmt"
+	"math/big"
 	"strings"
 	"testing"
 
@@ -44,6 +47,18 @@ func TestErrOpen(t *testing.T) {
 		_, err := sql.Open("duckdb", "?schema=main")
 		testError(t, err, errSetConfig.Error())
 	})
+
+	t.Run("invalid config option", func(t *testing.T) {
+		_, err := NewConnectorWithConfig("", map[string]string{"threads": "NaN"}, nil)
+		testError(t, err, errSetConfig.Error(), "threads=NaN")
+	})
+
+	t.Run("invalid connector options", func(t *testing.T) {
+		_, err := NewConnector("", nil, WithThreads(0))
+		testError(t, err, errSetConfig.Error(), "threads=0")
+		_, err = NewConnector("", nil, WithMemoryLimit("lots"))
+		testError(t, err, errSetConfig.Error(), "memory_limit=lots")
+	})
 }
 
 func TestErrNestedMap(t *testing.T) {
@@ -52,7 +67,7 @@ func TestErrNestedMap(t *testing.T) {
 
 	var m Map
 	err := db.QueryRow("SELECT MAP([MAP([1], [1]), MAP([2], [2])], ['a', 'e'])").Scan(&m)
-	testError(t, err, errUnsupportedMapKeyType.Error())
+	testError(t, err, errUnsupportedMapKeyType.Error(), "MAP(INTEGER, INTEGER)")
 	require.NoError(t, db.Close())
 }
 
@@ -91,6 +106,25 @@ func TestErrAppender(t *testing.T) {
 		require.NoError(t, c.Close())
 	})
 
+	t.Run("missing catalog or schema", func(t *testing.T) {
+		c, err := NewConnector("", nil)
+		require.NoError(t, err)
+
+		con, err := c.Connect(context.Background())
+		require.NoError(t, err)
+
+		_, err = NewAppenderWithSchema(con, "does_not_exist", "", "test")
+		testError(t, err, errAppenderCreation.Error())
+		require.Equal(t, ErrorTypeCatalog, GetErrorType(err))
+
+		_, err = NewAppenderWithSchema(con, "", "does_not_exist", "test")
+		testError(t, err, errAppenderCreation.Error(), duckdbErrMsg)
+		require.Equal(t, ErrorTypeCatalog, GetErrorType(err))
+
+		require.NoError(t, con.Close())
+		require.NoError(t, c.Close())
+	})
+
 	t.Run(errAppenderDoubleClose.Error(), func(t *testing.T) {
 		c, err := NewConnector("", nil)
 		require.NoError(t, err)
@@ -113,7 +147,7 @@ func TestErrAppender(t *testing.T) {
 		c, err := NewConnector("", nil)
 		require.NoError(t, err)
 
-		_, err = sql.OpenDB(c).Exec(`CREATE TABLE test (int_array INTEGER[2])`)
+		_, err = sql.OpenDB(c).Exec(`CREATE TABLE test (v VARINT)`)
 		require.NoError(t, err)
 
 		con, err := c.Connect(context.Background())
@@ -154,6 +188,20 @@ func TestErrAppender(t *testing.T) {
 		require.NoError(t, c.Close())
 	})
 
+	t.Run(errAppenderAppendAfterFlushErr.Error(), func(t *testing.T) {
+		c, con, a := prepareAppender(t, `CREATE TABLE test (c1 INTEGER PRIMARY KEY)`)
+		a.SetFlushThreshold(2)
+		require.NoError(t, a.AppendRow(int32(1)))
+		err := a.AppendRow(int32(1))
+		testError(t, err, errAppenderAppendRow.Error(), errAppenderFlush.Error())
+		err = a.AppendRow(int32(2))
+		testError(t, err, errAppenderAppendAfterFlushErr.Error())
+		err = a.Close()
+		testError(t, err, errAppenderClose.Error())
+		require.NoError(t, con.Close())
+		require.NoError(t, c.Close())
+	})
+
 	t.Run(errAppenderClose.Error(), func(t *testing.T) {
 		c, con, a := prepareAppender(t, `CREATE TABLE test (c1 INTEGER PRIMARY KEY)`)
 		require.NoError(t, a.AppendRow(int32(1)))
@@ -167,7 +215,7 @@ func TestErrAppender(t *testing.T) {
 	t.Run(errUnsupportedMapKeyType.Error(), func(t *testing.T) {
 		c, con, a := prepareAppender(t, `CREATE TABLE test (m MAP(INT[], STRUCT(v INT)))`)
 		err := a.AppendRow(nil)
-		testError(t, err, errAppenderAppendRow.Error(), errUnsupportedMapKeyType.Error())
+		testError(t, err, errAppenderAppendRow.Error(), errUnsupportedMapKeyType.Error(), "INTEGER[]")
 		cleanupAppender(t, c, con, a)
 	})
 }
@@ -176,9 +224,9 @@ func TestErrAppend(t *testing.T) {
 	c, con, a := prepareAppender(t, `CREATE TABLE test (id BIGINT, str VARCHAR)`)
 
 	err := a.AppendRow("hello", "world")
-	testError(t, err, errAppenderAppendRow.Error(), castErrMsg)
-	err = a.AppendRow(false, 42)
-	testError(t, err, errAppenderAppendRow.Error(), castErrMsg)
+	testError(t, err, errAppenderAppendRow.Error(), castErrMsg, "cannot cast string to int64", columnIndexErrMsg+": 0")
+	err = a.AppendRow(int64(1), 42)
+	testError(t, err, errAppenderAppendRow.Error(), castErrMsg, "cannot cast int to string", columnIndexErrMsg+": 1")
 
 	cleanupAppender(t, c, con, a)
 }
@@ -191,6 +239,14 @@ func TestErrAppendDecimal(t *testing.T) {
 	err = a.AppendRow(Decimal{Width: 8, Scale: 3})
 	testError(t, err, errAppenderAppendRow.Error(), castErrMsg)
 
+	// Appending a Decimal fails, if it exceeds the column's width or loses precision.
+	err = a.AppendRow(Decimal{Width: 9, Scale: 2, Value: big.NewInt(100000000)})
+	testError(t, err, errAppenderAppendRow.Error(), "1000000.00 exceeds DECIMAL(8,2)")
+	require.Equal(t, ErrorTypeDecimal, GetErrorType(err))
+	err = a.AppendRow(Decimal{Width: 8, Scale: 3, Value: big.NewInt(1234)})
+	testError(t, err, errAppenderAppendRow.Error(), "1.234 loses precision as DECIMAL(8,2)")
+	require.Equal(t, ErrorTypeDecimal, GetErrorType(err))
+
 	cleanupAppender(t, c, con, a)
 }
 
@@ -238,6 +294,29 @@ func TestErrAppendSimpleStruct(t *testing.T) {
 	cleanupAppender(t, c, con, a)
 }
 
+func TestErrAppendStructFields(t *testing.T) {
+	c, con, a := prepareAppender(t, `CREATE TABLE test (person STRUCT(name VARCHAR, age INT))`)
+
+	err := a.AppendRow(map[string]any{"name": "Alice"})
+	testError(t, err, errAppenderAppendRow.Error(), structFieldErrMsg, "missing field")
+	err = a.AppendRow(map[string]any{"name": "Alice", "age": int32(42), "email": "alice@duck.db"})
+	testError(t, err, errAppenderAppendRow.Error(), structFieldErrMsg, "unexpected field email")
+	err = a.AppendRow(map[string]any{"name": "Alice", "email": "alice@duck.db"})
+	testError(t, err, errAppenderAppendRow.Error(), structFieldErrMsg, "unexpected field email")
+	err = a.AppendRow(map[string]any{"name": "Alice", "age": "42"})
+	testError(t, err, errAppenderAppendRow.Error(), castErrMsg, fieldErrMsg+": age")
+
+	type person struct {
+		Name string `db:"name"`
+		Age  int32  `db:"age"`
+		City string
+	}
+	err = a.AppendRow(person{Name: "Alice", Age: 42})
+	testError(t, err, errAppenderAppendRow.Error(), structFieldErrMsg, "unexpected field City")
+
+	cleanupAppender(t, c, con, a)
+}
+
 func TestErrAppendDuplicateStruct(t *testing.T) {
 	c, con, a := prepareAppender(t, `
 		CREATE TABLE test (
@@ -267,6 +346,8 @@ func TestErrAppendList(t *testing.T) {
 	testError(t, err, errAppenderAppendRow.Error(), castErrMsg)
 	err = a.AppendRow([][]int32{{1, 2, 3}, {4, 5, 6}})
 	testError(t, err, errAppenderAppendRow.Error(), castErrMsg)
+	err = a.AppendRow([]any{int32(1), "two"})
+	testError(t, err, errAppenderAppendRow.Error(), castErrMsg, indexErrMsg+": 1")
 
 	cleanupAppender(t, c, con, a)
 }
@@ -307,6 +388,88 @@ func TestErrAppendNestedList(t *testing.T) {
 	cleanupAppender(t, c, con, a)
 }
 
+func TestErrAppendStructs(t *testing.T) {
+	t.Parallel()
+	c, con, a := prepareAppender(t, `CREATE TABLE test (id BIGINT, name VARCHAR)`)
+
+	type row struct {
+		ID   int64
+		Name string
+	}
+	type wrongTypeRow struct {
+		ID   int64
+		Name int64
+	}
+	type missingFieldRow struct {
+		ID int64
+	}
+	type wrongNameRow struct {
+		ID    int64
+		Other string
+	}
+
+	err := a.AppendStructs(42)
+	testError(t, err, errAppenderAppendRow.Error(), castErrMsg)
+	err = a.AppendStructs(nil)
+	testError(t, err, errAppenderAppendRow.Error(), castErrMsg, "<nil>")
+	err = a.AppendStructs([]int{42})
+	testError(t, err, errAppenderAppendRow.Error(), castErrMsg)
+	err = a.AppendStructs([]missingFieldRow{{ID: 1}})
+	testError(t, err, errAppenderAppendRow.Error(), columnCountErrMsg)
+	err = a.AppendStructs([]wrongNameRow{{ID: 1}})
+	testError(t, err, errAppenderAppendRow.Error(), structFieldErrMsg, "name")
+	err = a.AppendStructs([]wrongTypeRow{{ID: 1, Name: 2}})
+	testError(t, err, errAppenderAppendRow.Error(), castErrMsg, fieldErrMsg+": Name", indexErrMsg+": 0")
+	require.NoError(t, a.AppendStructs([]row{{ID: 1, Name: "one"}}))
+
+	cleanupAppender(t, c, con, a)
+}
+
+func TestErrAppendUUID(t *testing.T) {
+	t.Parallel()
+	c, con, a := prepareAppender(t, `CREATE TABLE test (id UUID)`)
+
+	err := a.AppendRow("not-a-uuid")
+	testError(t, err, errAppenderAppendRow.Error(), castErrMsg)
+	err = a.AppendRow("0123456789abcdef0123456789abcdef0123")
+	testError(t, err, errAppenderAppendRow.Error(), castErrMsg)
+	err = a.AppendRow("0123456z-89ab-cdef-0123-456789abcdef")
+	testError(t, err, errAppenderAppendRow.Error(), castErrMsg)
+	err = a.AppendRow([8]byte{})
+	testError(t, err, errAppenderAppendRow.Error(), castErrMsg)
+	err = a.AppendRow(json.RawMessage("abc"))
+	testError(t, err, errAppenderAppendRow.Error(), castErrMsg)
+	require.NoError(t, a.AppendRow(json.RawMessage("0123456789abcdef")))
+
+	cleanupAppender(t, c, con, a)
+}
+
+func TestErrStmtParams(t *testing.T) {
+	t.Parallel()
+	c, err := NewConnector("", nil)
+	require.NoError(t, err)
+	con, err := c.Connect(context.Background())
+	require.NoError(t, err)
+
+	s, err := con.Prepare(`SELECT ?::INTEGER, ?`)
+	require.NoError(t, err)
+	stmt := s.(*Stmt)
+
+	_, err = stmt.ParamTypes()
+	testError(t, err, errAPI.Error(), errUnresolvedParamType.Error(), indexErrMsg+": 2")
+	_, err = stmt.ParamIndex("foo")
+	testError(t, err, errAPI.Error(), errParamNotFound.Error(), "foo")
+
+	require.NoError(t, stmt.Close())
+	_, err = stmt.ParamTypes()
+	testError(t, err, errClosedStmt.Error())
+	_, err = stmt.ParamIndex("foo")
+	testError(t, err, errClosedStmt.Error())
+
+	require.NoError(t, con.Close())
+	require.NoError(t, c.Close())
+}
+
 func TestErrAPISetValue(t *testing.T) {
 	t.Parallel()
 
@@ -317,6 +480,15 @@ func TestErrAPISetValue(t *testing.T) {
 	testError(t, err, errAPI.Error(), columnCountErrMsg)
 }
 
+func TestErrAPISetSize(t *testing.T) {
+	t.Parallel()
+
+	var chunk DataChunk
+	err := chunk.SetSize(VectorSize + 1)
+	require.ErrorIs(t, err, errVectorSize)
+	testError(t, err, errAPI.Error(), fmt.Sprintf("requested %d rows", VectorSize+1), fmt.Sprintf("vector size is %d", VectorSize))
+}
+
 func TestDuckDBErrors(t *testing.T) {
 	db := openDB(t)
 	createTable(db, t, `CREATE TABLE duckdb_error_test(bar VARCHAR UNIQUE, baz INT32, u_1 UNION("string" VARCHAR))`)
@@ -459,3 +631,115 @@ func TestGetDuckDBErrorIs(t *testing.T) {
 	require.Equal(t, false, errors.Is(invalidInputErr, outOfRangeErr1))
 	require.Equal(t, false, errors.Is(errors.New(errMsg), outOfRangeErr1))
 }
+
+func TestGetDuckDBErrorIsType(t *testing.T) {
+	constraintErr := &Error{
+		Type: ErrorTypeConstraint,
+		Msg:  "Constraint Error: Duplicate key \"bar: bar\" violates unique constraint.",
+	}
+	ioErr := &Error{
+		Type: ErrorTypeIO,
+		Msg:  "IO Error: No files found that match the pattern \"not_exist.csv\"",
+	}
+
+	require.ErrorIs(t, constraintErr, &Error{Type: ErrorTypeConstraint})
+	require.ErrorIs(t, &wrappedDuckDBError{constraintErr}, &Error{Type: ErrorTypeConstraint})
+	require.ErrorIs(t, ioErr, &Error{Type: ErrorTypeIO})
+	require.Equal(t, false, errors.Is(ioErr, &Error{Type: ErrorTypeConstraint}))
+	require.Equal(t, false, errors.Is(constraintErr, &Error{Type: ErrorTypeConstraint, Msg: "Constraint Error: xxx"}))
+
+	db := openDB(t)
+	createTable(db, t, `CREATE TABLE is_type_test(bar VARCHAR UNIQUE)`)
+	_, err := db.Exec(`INSERT INTO is_type_test VALUES ('bar')`)
+	require.NoError(t, err)
+	_, err = db.Exec(`INSERT INTO is_type_test VALUES ('bar')`)
+	require.ErrorIs(t, err, &Error{Type: ErrorTypeConstraint})
+	require.NoError(t, db.Close())
+}
+
+func TestErrorSentinels(t *testing.T) {
+	db := openDB(t)
+	defer db.Close()
+
+	createTable(db, t, `CREATE TABLE sentinel_test(id INTEGER PRIMARY KEY)`)
+	_, err := db.Exec(`INSERT INTO sentinel_test VALUES (1)`)
+	require.NoError(t, err)
+	_, err = db.Exec(`INSERT INTO sentinel_test VALUES (1)`)
+	require.ErrorIs(t, err, ErrConstraintViolation)
+	require.NotErrorIs(t, err, ErrIOError)
+	require.NotErrorIs(t, err, ErrCatalog)
+
+	_, err = db.Query(`SELECT * FROM does_not_exist`)
+	require.ErrorIs(t, err, ErrCatalog)
+	require.NotErrorIs(t, err, ErrConstraintViolation)
+
+	_, err = db.Exec(`SELECT 1::TINYINT + 200::TINYINT`)
+	require.ErrorIs(t, err, ErrConversion)
+
+	// The sentinels are errors of their type, and their messages name it.
+	require.Equal(t, ErrorTypeConstraint, GetErrorType(ErrConstraintViolation))
+	require.Equal(t, "Constraint Error", ErrConstraintViolation.Error())
+	require.Equal(t, "Out of Memory Error", ErrOutOfMemory.Error())
+	require.Equal(t, "IO Error", ErrIOError.Error())
+}
+
+func TestGetErrorType(t *testing.T) {
+	catalogErr := getDuckDBError("Catalog Error: Table with name not_exist does not exist!")
+
+	require.Equal(t, ErrorTypeCatalog, GetErrorType(catalogErr))
+	require.Equal(t, ErrorTypeCatalog, GetErrorType(&wrappedDuckDBError{catalogErr.(*Error)}))
+	require.Equal(t, ErrorTypeCatalog, GetErrorType(getError(errAppenderFlush, catalogErr)))
+	require.Equal(t, ErrorTypeCatalog, GetErrorType(fmt.Errorf("outer: %w", catalogErr)))
+	require.Equal(t, ErrorTypeInvalid, GetErrorType(errors.New("Catalog Error: not a DuckDB error")))
+	require.Equal(t, ErrorTypeInvalid, GetErrorType(getError(errAppenderFlush, nil)))
+	require.Equal(t, ErrorTypeInvalid, GetErrorType(nil))
+
+	db := openDB(t)
+	_, err := db.Exec(`SELECT * FROM not_exist`)
+	require.Equal(t, ErrorTypeCatalog, GetErrorType(err))
+	require.NoError(t, db.Close())
+}
+
+func TestGetDuckDBErrorPrefixes(t *testing.T) {
+	testCases := []struct {
+		msg    string
+		errTyp ErrorType
+	}{
+		{`Catalog Error: Table with name not_exist does not exist!`, ErrorTypeCatalog},
+		{`Binder Error: Referenced column "col" not found in FROM clause!`, ErrorTypeBinder},
+		{`Binder Error`, ErrorTypeBinder},
+		{`Parser Error: syntax error at or near "SELEC"`, ErrorTypeParser},
+		{`Constraint Error: Duplicate key "bar: bar" violates unique constraint.`, ErrorTypeConstraint},
+		{`Conversion Error: Type UINT64 with value 18446744073709551615 can't be cast`, ErrorTypeConversion},
+		{`HTTP Error: Failed to download extension "not_exist"`, ErrorTypeHTTP},
+		{`IO Error: Extension "not_exist.duckdb_extension" not found.`, ErrorTypeIO},
+		{`Out of Range Error: array_length dimension '3' out of range (min: '1', max: '2')`, ErrorTypeOutOfRange},
+		{`Invalid Input Error: Cannot AND bit strings of different sizes`, ErrorTypeInvalidInput},
+		{`Syntax Error: Must have a non-negative number of external threads!`, ErrorTypeSyntax},
+		{`Invalid type Error: Invalid Type [UNION]: Invalid type for index`, ErrorTypeInvalidType},
+		{`TransactionContext Error: Catalog write-write conflict on create with "tbl"`, ErrorTypeTransaction},
+		{`INTERRUPT Error: Interrupted!`, ErrorTypeInterrupt},
+		{`FATAL Error: Failed: database has been invalidated`, ErrorTypeFatal},
+		{`Not implemented Error: Unsupported type`, ErrorTypeNotImplemented},
+		{`Dependency Error: Cannot drop entry "t" because there are entries that depend on it.`, ErrorTypeDependency},
+		{`Sequence Error: nextval: reached maximum value of sequence "seq" (2)`, ErrorTypeSequence},
+		{`Missing Extension Error: An extension is required`, ErrorTypeMissingExtension},
+		{`Extension Autoloading Error: An error occurred while trying to automatically install`, ErrorTypeAutoLoad},
+		// Casing and missing colons.
+		{`catalog error: Table with name not_exist does not exist!`, ErrorTypeCatalog},
+		{`BINDER ERROR: Referenced column "col" not found`, ErrorTypeBinder},
+		{`Interrupt Error: Interrupted!`, ErrorTypeInterrupt},
+		{`Binder Error Referenced column "col" not found`, ErrorTypeBinder},
+		// Prefixes that do not start the message.
+		{`could not prepare: Catalog Error: Table with name not_exist does not exist!`, ErrorTypeCatalog},
+		{`Failed to execute: Invalid Input Error: Map keys can not be NULL`, ErrorTypeInvalidInput},
+		{`unrelated message`, ErrorTypeInvalid},
+	}
+
+	for _, tc := range testCases {
+		var err *Error
+		require.True(t, errors.As(getDuckDBError(tc.msg), &err))
+		require.Equal(t, tc.errTyp, err.Type, "msg: %s", tc.msg)
+		require.Equal(t, tc.msg, err.Msg)
+	}
+}
@@ -0,0 +1,111 @@
+package duckdb
+
+import (
+	"context"
+	"database/sql/driver"
+	"encoding/json"
+	"errors"
+	"io"
+	"regexp"
+	"strconv"
+)
+
+// EstimateRows returns the number of result rows of a query, as estimated by DuckDB's optimizer without executing
+// the query, e.g., to size a progress bar. It is the estimated cardinality of the root operator of the EXPLAIN output.
+// ok is false, if the plan has no estimate, e.g., for statements without a physical plan.
+// Estimates can be far off, especially for joins and filters on columns without statistics.
+func (c *Connector) EstimateRows(ctx context.Context, query string, args ...any) (estimate int64, ok bool, err error) {
+	driverConn, err := c.Connect(ctx)
+	if err != nil {
+		return 0, false, err
+	}
+	defer driverConn.Close()
+	con := driverConn.(*conn)
+
+	nargs := make([]driver.NamedValue, len(args))
+	for i, arg := range args {
+		nargs[i] = driver.NamedValue{Ordinal: i + 1, Value: arg}
+	}
+
+	// Prefer the JSON format, and fall back to parsing the text format, which has been stable for longer.
+	plan, err := con.explain(ctx, "EXPLAIN (FORMAT JSON) "+query, nargs)
+	if err == nil {
+		if estimate, ok = jsonPlanEstimate(plan); ok {
+			return estimate, true, nil
+		}
+	}
+	plan, err = con.explain(ctx, "EXPLAIN "+query, nargs)
+	if err != nil {
+		return 0, false, getError(errEstimateRows, err)
+	}
+	estimate, ok = textPlanEstimate(plan)
+	return estimate, ok, nil
+}
+
+// explain returns the physical plan of an EXPLAIN statement. It prepares the statement,
+// so that it never executes further statements of the query.
+func (c *conn) explain(ctx context.Context, query string, args []driver.NamedValue) (string, error) {
+	stmt, err := c.prepareStmt(query)
+	if err != nil {
+		return "", err
+	}
+	stmt.closeOnRowsClose = true
+
+	res, err := stmt.QueryContext(ctx, args)
+	if err != nil {
+		return "", errors.Join(err, stmt.Close())
+	}
+	r := res.(*rows)
+	defer r.Close()
+
+	// EXPLAIN returns the plans as key-value pairs.
+	dest := make([]driver.Value, len(r.Columns()))
+	for {
+		if err = r.Next(dest); err != nil {
+			if errors.Is(err, io.EOF) {
+				return "", nil
+			}
+			return "", err
+		}
+		if len(dest) == 2 && dest[0] == "physical_plan" {
+			plan, _ := dest[1].(string)
+			return plan, nil
+		}
+	}
+}
+
+// estimatedCardinalityKey is the key of the estimate in the extra information of a JSON plan operator.
+const estimatedCardinalityKey = "Estimated Cardinality"
+
+// jsonPlanEstimate returns the estimated cardinality of the root operator of a JSON plan.
+func jsonPlanEstimate(plan string) (int64, bool) {
+	var operators []struct {
+		ExtraInfo map[string]any `json:"extra_info"`
+	}
+	if err := json.Unmarshal([]byte(plan), &operators); err != nil || len(operators) == 0 {
+		return 0, false
+	}
+
+	switch v := operators[0].ExtraInfo[estimatedCardinalityKey].(type) {
+	case string:
+		estimate, err := strconv.ParseInt(v, 10, 64)
+		return estimate, err == nil
+	case float64:
+		return int64(v), true
+	default:
+		return 0, false
+	}
+}
+
+// textPlanRowsRegexp matches the estimate of an operator in a text plan, e.g., ~100 Rows.
+var textPlanRowsRegexp = regexp.MustCompile(`~(\d+) Rows`)
+
+// textPlanEstimate returns the estimated cardinality of the root operator of a text plan, which is the topmost estimate.
+func textPlanEstimate(plan string) (int64, bool) {
+	match := textPlanRowsRegexp.FindStringSubmatch(plan)
+	if match == nil {
+		return 0, false
+	}
+	estimate, err := strconv.ParseInt(match[1], 10, 64)
+	return estimate, err == nil
+}
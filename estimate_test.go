@@ -0,0 +1,65 @@
+package duckdb
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestEstimateRows(t *testing.T) {
+	t.Parallel()
+	c, err := NewConnector("", nil)
+	require.NoError(t, err)
+	db := sql.OpenDB(c)
+	defer db.Close()
+
+	_, err = db.Exec(`CREATE TABLE orders AS SELECT range AS id, range % 100 AS customer_id FROM range(1000)`)
+	require.NoError(t, err)
+	_, err = db.Exec(`CREATE TABLE customers AS SELECT range AS id, range % 10 AS region FROM range(100)`)
+	require.NoError(t, err)
+
+	ctx := context.Background()
+	estimate, ok, err := c.EstimateRows(ctx, `SELECT * FROM orders`)
+	require.NoError(t, err)
+	require.True(t, ok)
+	require.Equal(t, int64(1000), estimate)
+
+	// The estimate of a join is positive, but it does not need to match the actual row count.
+	estimate, ok, err = c.EstimateRows(ctx, `SELECT * FROM orders o JOIN customers c ON o.customer_id = c.id WHERE c.region = ?`, 1)
+	require.NoError(t, err)
+	require.True(t, ok)
+	require.Positive(t, estimate)
+
+	// EstimateRows does not execute the query, nor any further statements.
+	_, _, err = c.EstimateRows(ctx, `SELECT 1; DROP TABLE orders`)
+	require.ErrorIs(t, err, errEstimateRows)
+	_, _, err = c.EstimateRows(ctx, `DELETE FROM orders`)
+	require.NoError(t, err)
+	var count int
+	require.NoError(t, db.QueryRow(`SELECT count(*) FROM orders`).Scan(&count))
+	require.Equal(t, 1000, count)
+
+	_, _, err = c.EstimateRows(ctx, `SELECT * FROM missing`)
+	require.ErrorIs(t, err, errEstimateRows)
+	require.ErrorIs(t, err, ErrCatalog)
+}
+
+func TestPlanEstimate(t *testing.T) {
+	t.Parallel()
+
+	estimate, ok := jsonPlanEstimate(`[{"name": "PROJECTION", "children": [], "extra_info": {"Estimated Cardinality": "42"}}]`)
+	require.True(t, ok)
+	require.Equal(t, int64(42), estimate)
+	_, ok = jsonPlanEstimate(`[{"name": "PROJECTION", "children": [], "extra_info": {}}]`)
+	require.False(t, ok)
+	_, ok = jsonPlanEstimate(`not json`)
+	require.False(t, ok)
+
+	estimate, ok = textPlanEstimate("│         PROJECTION        │\n│         ~100 Rows         │\n│         ~1000 Rows        │")
+	require.True(t, ok)
+	require.Equal(t, int64(100), estimate)
+	_, ok = textPlanEstimate("│         PROJECTION        │")
+	require.False(t, ok)
+}
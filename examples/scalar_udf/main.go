@@ -6,6 +6,7 @@ import (
 	"database/sql/driver"
 	"errors"
 	"fmt"
+	"math"
 
 	"github.com/marcboeker/go-duckdb"
 )
@@ -153,9 +154,83 @@ func wrapSumScalarUDF() {
 	check(db.Close())
 }
 
+// haversine takes the latitude and longitude of two points in degrees.
+// It computes the great-circle distance between the two points in kilometers.
+// If any of the input values is NULL, then the result is NULL.
+type haversine struct{}
+
+const earthRadiusKm = 6371.0
+
+func haversineFn(values []driver.Value) (any, error) {
+	toRad := func(v driver.Value) float64 {
+		return v.(float64) * math.Pi / 180
+	}
+	lat1, lon1 := toRad(values[0]), toRad(values[1])
+	lat2, lon2 := toRad(values[2]), toRad(values[3])
+
+	a := math.Pow(math.Sin((lat2-lat1)/2), 2) +
+		math.Cos(lat1)*math.Cos(lat2)*math.Pow(math.Sin((lon2-lon1)/2), 2)
+	return 2 * earthRadiusKm * math.Asin(math.Sqrt(a)), nil
+}
+
+func (*haversine) Config() duckdb.ScalarFuncConfig {
+	doubleTypeInfo, err := duckdb.NewTypeInfo(duckdb.TYPE_DOUBLE)
+	check(err)
+
+	return duckdb.ScalarFuncConfig{
+		InputTypeInfos: []duckdb.TypeInfo{doubleTypeInfo, doubleTypeInfo, doubleTypeInfo, doubleTypeInfo},
+		ResultTypeInfo: doubleTypeInfo,
+	}
+}
+
+func (*haversine) Executor() duckdb.ScalarFuncExecutor {
+	return duckdb.ScalarFuncExecutor{RowExecutor: haversineFn}
+}
+
+func haversineScalarUDF() {
+	db, err := sql.Open("duckdb", "?access_mode=READ_WRITE")
+	check(err)
+
+	c, err := db.Conn(context.Background())
+	check(err)
+
+	var haversineUDF *haversine
+	err = duckdb.RegisterScalarUDF(c, "haversine", haversineUDF)
+	check(err)
+
+	_, err = db.Exec(`CREATE TABLE cities (name VARCHAR, lat DOUBLE, lon DOUBLE)`)
+	check(err)
+	_, err = db.Exec(`INSERT INTO cities VALUES ('Berlin', 52.52, 13.405), ('Amsterdam', 52.3676, 4.9041), ('Unknown', NULL, NULL)`)
+	check(err)
+
+	rows, err := db.Query(`
+		SELECT a.name, b.name, haversine(a.lat, a.lon, b.lat, b.lon) AS distance
+		FROM cities a, cities b
+		WHERE a.name < b.name
+		ORDER BY ALL`)
+	check(err)
+
+	for rows.Next() {
+		var from, to string
+		var distance *float64
+		check(rows.Scan(&from, &to, &distance))
+		if distance == nil {
+			fmt.Printf("%s -> %s: unknown\n", from, to)
+			continue
+		}
+		fmt.Printf("%s -> %s: %.1f km\n", from, to, *distance)
+	}
+	check(rows.Err())
+	check(rows.Close())
+
+	check(c.Close())
+	check(db.Close())
+}
+
 func main() {
 	myLengthScalarUDFSet()
 	wrapSumScalarUDF()
+	haversineScalarUDF()
 }
 
 func check(args ...interface{}) {
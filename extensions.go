@@ -0,0 +1,122 @@
+package duckdb
+
+import (
+	"context"
+	"database/sql/driver"
+	"errors"
+	"io"
+	"strings"
+)
+
+// ExtensionInfo describes an extension, as listed by duckdb_extensions().
+type ExtensionInfo struct {
+	// Name is the name of the extension, e.g., parquet.
+	Name string
+	// Loaded is true, if the extension is loaded into the database.
+	Loaded bool
+	// Installed is true, if the extension is installed, or if it is built into DuckDB.
+	Installed bool
+	// Version is the version of the extension. It is empty, if the extension is not installed.
+	Version string
+	// InstallPath is the file of an installed extension, or (BUILT-IN) for built-in extensions.
+	InstallPath string
+}
+
+// InstallExtension installs the extension with the given name from the default extension repository.
+func (c *Connector) InstallExtension(name string) error {
+	return c.InstallExtensionFrom(name, "", "")
+}
+
+// InstallExtensionFrom installs the extension with the given name from a specific repository and in a specific version.
+// The repository is either a repository alias, e.g., core_nightly, or a URL. The version is an extension version, e.g., a
+// git commit hash. Empty values fall back to the default repository and version, respectively.
+func (c *Connector) InstallExtensionFrom(name string, repository string, version string) error {
+	if name == "" {
+		return getError(errInstallExtension, errEmptyName)
+	}
+
+	query := "INSTALL " + quoteIdentifier(name)
+	if repository != "" {
+		if strings.Contains(repository, "/") {
+			query += " FROM " + quoteString(repository)
+		} else {
+			query += " FROM " + quoteIdentifier(repository)
+		}
+	}
+	if version != "" {
+		query += " VERSION " + quoteString(version)
+	}
+
+	if err := c.exec(query); err != nil {
+		return getError(errInstallExtension, err)
+	}
+	return nil
+}
+
+// LoadExtension loads the installed or built-in extension with the given name into the database.
+// A failure preserves the DuckDB error type, e.g., ErrorTypeIO, if the extension is not installed.
+func (c *Connector) LoadExtension(name string) error {
+	if name == "" {
+		return getError(errLoadExtension, errEmptyName)
+	}
+	if err := c.exec("LOAD " + quoteIdentifier(name)); err != nil {
+		return getError(errLoadExtension, err)
+	}
+	return nil
+}
+
+// LoadedExtensions returns the extensions known to the database, including their loaded and installed flags,
+// e.g., to verify that an extension, such as httpfs, is available in a deployment.
+func (c *Connector) LoadedExtensions() ([]ExtensionInfo, error) {
+	driverConn, err := c.Connect(context.Background())
+	if err != nil {
+		return nil, err
+	}
+	defer driverConn.Close()
+
+	res, err := driverConn.(driver.QueryerContext).QueryContext(context.Background(), `
+		SELECT extension_name, loaded, installed, coalesce(extension_version, ''), coalesce(install_path, '')
+		FROM duckdb_extensions()
+		ORDER BY extension_name`, nil)
+	if err != nil {
+		return nil, getError(errExtensions, err)
+	}
+	defer res.Close()
+
+	var extensions []ExtensionInfo
+	dest := make([]driver.Value, 5)
+	for {
+		if err = res.Next(dest); err != nil {
+			if errors.Is(err, io.EOF) {
+				return extensions, nil
+			}
+			return nil, getError(errExtensions, err)
+		}
+		extensions = append(extensions, ExtensionInfo{
+			Name:        dest[0].(string),
+			Loaded:      dest[1].(bool),
+			Installed:   dest[2].(bool),
+			Version:     dest[3].(string),
+			InstallPath: dest[4].(string),
+		})
+	}
+}
+
+func (c *Connector) exec(query string) error {
+	driverConn, err := c.Connect(context.Background())
+	if err != nil {
+		return err
+	}
+	defer driverConn.Close()
+
+	_, err = driverConn.(driver.ExecerContext).ExecContext(context.Background(), query, nil)
+	return err
+}
+
+func quoteIdentifier(s string) string {
+	return `"` + strings.ReplaceAll(s, `"`, `""`) + `"`
+}
+
+func quoteString(s string) string {
+	return `'` + strings.ReplaceAll(s, `'`, `''`) + `'`
+}
@@ -0,0 +1,118 @@
+package duckdb
+
+/*
+#include <duckdb.h>
+*/
+import "C"
+
+import (
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"unsafe"
+)
+
+// instance is a DuckDB database instance, which multiple Connectors can share.
+type instance struct {
+	db C.duckdb_database
+	// key identifies a shared instance in the instance cache. It is empty for isolated instances.
+	key string
+	// refs is the number of Connectors using the instance.
+	refs int
+	// inMemory is true, if the instance is an in-memory database.
+	inMemory bool
+}
+
+// instanceCache holds the shared database instances by their path and configuration.
+type instanceCache struct {
+	mu        sync.Mutex
+	instances map[string]*instance
+}
+
+var instances = instanceCache{instances: make(map[string]*instance)}
+
+// instanceKey returns the cache key of a database, which consists of the absolute path and the sorted configuration.
+// It returns false for in-memory databases, which we never share.
+func instanceKey(path string, config map[string]string) (string, bool) {
+	if isInMemoryPath(path) {
+		return "", false
+	}
+	absPath, err := filepath.Abs(path)
+	if err != nil {
+		return "", false
+	}
+
+	keys := make([]string, 0, len(config))
+	for k := range config {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	var b strings.Builder
+	b.WriteString(absPath)
+	for _, k := range keys {
+		b.WriteString("\x00" + k + "=" + config[k])
+	}
+	return b.String(), true
+}
+
+// acquire returns the shared instance of key, and opens it, if it does not exist yet.
+func (cache *instanceCache) acquire(key string, path string, config map[string]string) (*instance, error) {
+	cache.mu.Lock()
+	defer cache.mu.Unlock()
+
+	if inst, ok := cache.instances[key]; ok {
+		inst.refs++
+		return inst, nil
+	}
+
+	inst, err := openInstance(path, config)
+	if err != nil {
+		return nil, err
+	}
+	inst.key = key
+	cache.instances[key] = inst
+	return inst, nil
+}
+
+// release closes the instance, if no other Connector uses it.
+func (cache *instanceCache) release(inst *instance) {
+	cache.mu.Lock()
+	defer cache.mu.Unlock()
+
+	inst.refs--
+	if inst.refs > 0 {
+		return
+	}
+	if inst.key != "" {
+		delete(cache.instances, inst.key)
+	}
+	C.duckdb_close(&inst.db)
+}
+
+func openInstance(path string, config map[string]string) (*instance, error) {
+	duckdbConfig, err := prepareConfig(config)
+	if err != nil {
+		return nil, err
+	}
+	defer C.duckdb_destroy_config(&duckdbConfig)
+
+	connStr := C.CString(path)
+	defer C.duckdb_free(unsafe.Pointer(connStr))
+
+	var outError *C.char
+	defer C.duckdb_free(unsafe.Pointer(outError))
+
+	var db C.duckdb_database
+	if state := C.duckdb_open_ext(connStr, &db, duckdbConfig, &outError); state == C.DuckDBError {
+		return nil, getError(errOpen, duckdbError(outError))
+	}
+	return &instance{db: db, refs: 1, inMemory: isInMemoryPath(path)}, nil
+}
+
+// isInMemoryPath returns true, if DuckDB opens an in-memory database for path, i.e., for an empty path or ':memory:'.
+// DuckDB opens any other path as a file, e.g., ':memory:name'.
+func isInMemoryPath(path string) bool {
+	return path == "" || path == ":memory:"
+}
@@ -0,0 +1,77 @@
+package duckdb
+
+import (
+	"context"
+	"database/sql/driver"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// MemoryUsage returns the number of bytes that the database currently uses in memory, and its memory limit,
+// e.g., to apply backpressure before the database runs out of memory.
+// used is the sum of duckdb_memory(), i.e., the memory of the buffer manager, excluding small allocations.
+// DuckDB reports the limit rounded to one decimal place, e.g., 30.5 MiB for a memory_limit of 32MB,
+// so limit is approximate. Without a limit, limit is math.MaxInt64.
+func (c *Connector) MemoryUsage() (used int64, limit int64, err error) {
+	driverConn, err := c.Connect(context.Background())
+	if err != nil {
+		return 0, 0, err
+	}
+	defer driverConn.Close()
+
+	res, err := driverConn.(driver.QueryerContext).QueryContext(context.Background(), `
+		SELECT
+			(SELECT coalesce(sum(memory_usage_bytes), 0)::BIGINT FROM duckdb_memory()),
+			(SELECT value FROM duckdb_settings() WHERE name = 'memory_limit')`, nil)
+	if err != nil {
+		return 0, 0, getError(errMemoryUsage, err)
+	}
+	defer res.Close()
+
+	dest := make([]driver.Value, 2)
+	if err = res.Next(dest); err != nil {
+		return 0, 0, getError(errMemoryUsage, err)
+	}
+	used = dest[0].(int64)
+	s, _ := dest[1].(string)
+	if limit, err = parseByteSize(s); err != nil {
+		return 0, 0, getError(errMemoryUsage, err)
+	}
+	return used, limit, nil
+}
+
+// byteUnits are the multipliers of the units of DuckDB's formatted byte sizes.
+var byteUnits = map[string]float64{
+	"bytes": 1,
+	"kib":   1 << 10,
+	"mib":   1 << 20,
+	"gib":   1 << 30,
+	"tib":   1 << 40,
+	"pib":   1 << 50,
+	"kb":    1e3,
+	"mb":    1e6,
+	"gb":    1e9,
+	"tb":    1e12,
+	"pb":    1e15,
+}
+
+// parseByteSize parses a byte size formatted by DuckDB, e.g., 4.6 GiB or 1000 bytes.
+// Sizes beyond math.MaxInt64 return math.MaxInt64.
+func parseByteSize(s string) (int64, error) {
+	number, unit, ok := strings.Cut(strings.TrimSpace(s), " ")
+	multiplier, known := byteUnits[strings.ToLower(unit)]
+	if !ok || !known {
+		return 0, fmt.Errorf("invalid byte size %q", s)
+	}
+	f, err := strconv.ParseFloat(number, 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid byte size %q", s)
+	}
+	size := f * multiplier
+	if size >= math.MaxInt64 {
+		return math.MaxInt64, nil
+	}
+	return int64(size), nil
+}
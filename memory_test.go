@@ -0,0 +1,62 @@
+package duckdb
+
+import (
+	"database/sql"
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestMemoryUsage(t *testing.T) {
+	t.Parallel()
+	c, err := NewConnector("", nil, WithMemoryLimit("64MB"))
+	require.NoError(t, err)
+	db := sql.OpenDB(c)
+
+	used, limit, err := c.MemoryUsage()
+	require.NoError(t, err)
+	require.GreaterOrEqual(t, used, int64(0))
+	// DuckDB reports the limit as 61.0 MiB.
+	require.InDelta(t, 64e6, float64(limit), 0.1*(1<<20))
+
+	// Loading data increases the memory usage.
+	_, err = db.Exec(`CREATE TABLE tbl AS SELECT i, i::VARCHAR AS s FROM range(500000) t(i)`)
+	require.NoError(t, err)
+	usedAfterLoad, limitAfterLoad, err := c.MemoryUsage()
+	require.NoError(t, err)
+	require.Greater(t, usedAfterLoad, used)
+	require.Greater(t, usedAfterLoad, int64(500000*8))
+	require.Less(t, usedAfterLoad, limitAfterLoad)
+	require.Equal(t, limit, limitAfterLoad)
+
+	require.NoError(t, db.Close())
+	_, _, err = c.MemoryUsage()
+	testError(t, err, errConnect.Error())
+}
+
+func TestParseByteSize(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		s    string
+		want int64
+	}{
+		{"0 bytes", 0},
+		{"1000 bytes", 1000},
+		{"97.6 KiB", 99942},
+		{"30.5 MiB", 31981568},
+		{"4.6 GiB", 4939212390},
+		{"1.5 GB", 1500000000},
+		{"16383.9 PiB", math.MaxInt64},
+	}
+	for _, test := range tests {
+		got, err := parseByteSize(test.s)
+		require.NoError(t, err, test.s)
+		require.Equal(t, test.want, got, test.s)
+	}
+
+	for _, s := range []string{"", "42", "a MiB", "42 lightyears"} {
+		_, err := parseByteSize(s)
+		require.Error(t, err, s)
+	}
+}
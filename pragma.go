@@ -0,0 +1,84 @@
+package duckdb
+
+import (
+	"context"
+	"database/sql"
+	"math"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+// Pragma executes the PRAGMA statement with the given name and returns its result rows,
+// e.g., Pragma(ctx, c, "table_info", "my_table") executes PRAGMA table_info('my_table').
+// Without arguments, it executes PRAGMA name, e.g., PRAGMA database_list.
+// DuckDB cannot prepare PRAGMA statements, so Pragma quotes the name and inlines the arguments as SQL literals.
+// The arguments can be nil, strings, booleans, integers, and finite floats.
+func Pragma(ctx context.Context, c *sql.Conn, name string, args ...any) (*sql.Rows, error) {
+	if name == "" {
+		return nil, getError(errPragma, errEmptyName)
+	}
+
+	query := "PRAGMA " + quoteIdentifier(name)
+	if len(args) != 0 {
+		literals := make([]string, len(args))
+		for i, arg := range args {
+			literal, err := pragmaLiteral(arg)
+			if err != nil {
+				return nil, getError(errPragma, addIndexToError(err, i))
+			}
+			literals[i] = literal
+		}
+		query += "(" + strings.Join(literals, ", ") + ")"
+	}
+
+	rows, err := c.QueryContext(ctx, query)
+	if err != nil {
+		return nil, getError(errPragma, err)
+	}
+	return rows, nil
+}
+
+// SetPragma executes the assignment form of a PRAGMA statement, e.g., SetPragma(ctx, c, "threads", 4)
+// executes PRAGMA threads = 4. The value follows the rules of the arguments of Pragma.
+func SetPragma(ctx context.Context, c *sql.Conn, name string, value any) error {
+	if name == "" {
+		return getError(errSetPragma, errEmptyName)
+	}
+
+	literal, err := pragmaLiteral(value)
+	if err != nil {
+		return getError(errSetPragma, err)
+	}
+	if _, err = c.ExecContext(ctx, "PRAGMA "+quoteIdentifier(name)+" = "+literal); err != nil {
+		return getError(errSetPragma, err)
+	}
+	return nil
+}
+
+// pragmaLiteral returns the SQL literal of a PRAGMA argument.
+func pragmaLiteral(val any) (string, error) {
+	if val == nil {
+		return "NULL", nil
+	}
+
+	v := reflect.ValueOf(val)
+	switch v.Kind() {
+	case reflect.String:
+		return quoteString(v.String()), nil
+	case reflect.Bool:
+		return strconv.FormatBool(v.Bool()), nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return strconv.FormatInt(v.Int(), 10), nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return strconv.FormatUint(v.Uint(), 10), nil
+	case reflect.Float32, reflect.Float64:
+		f := v.Float()
+		if math.IsNaN(f) || math.IsInf(f, 0) {
+			return "", unsupportedTypeError(strconv.FormatFloat(f, 'g', -1, 64))
+		}
+		return strconv.FormatFloat(f, 'g', -1, v.Type().Bits()), nil
+	default:
+		return "", unsupportedTypeError(reflect.TypeOf(val).String())
+	}
+}
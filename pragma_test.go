@@ -0,0 +1,90 @@
+package duckdb
+
+import (
+	"context"
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestPragma(t *testing.T) {
+	t.Parallel()
+	db := openDB(t)
+	defer db.Close()
+
+	con, err := db.Conn(context.Background())
+	require.NoError(t, err)
+	defer con.Close()
+
+	_, err = con.ExecContext(context.Background(), `CREATE TABLE "it's" (id INTEGER PRIMARY KEY, name VARCHAR)`)
+	require.NoError(t, err)
+
+	// The function-call form quotes its arguments.
+	rows, err := Pragma(context.Background(), con, "table_info", "it's")
+	require.NoError(t, err)
+	var names []string
+	for rows.Next() {
+		var cid int32
+		var name, typ string
+		var notNull, pk bool
+		var dflt any
+		require.NoError(t, rows.Scan(&cid, &name, &typ, &notNull, &dflt, &pk))
+		names = append(names, name+" "+typ)
+	}
+	require.NoError(t, rows.Err())
+	require.NoError(t, rows.Close())
+	require.Equal(t, []string{"id INTEGER", "name VARCHAR"}, names)
+
+	// A pragma without arguments.
+	rows, err = Pragma(context.Background(), con, "database_list")
+	require.NoError(t, err)
+	require.True(t, rows.Next())
+	require.NoError(t, rows.Close())
+
+	// The assignment form.
+	require.NoError(t, SetPragma(context.Background(), con, "threads", 3))
+	value, err := Setting(con, "threads")
+	require.NoError(t, err)
+	require.Equal(t, "3", value)
+	require.NoError(t, SetPragma(context.Background(), con, "default_order", "desc"))
+	value, err = Setting(con, "default_order")
+	require.NoError(t, err)
+	require.Equal(t, "desc", value)
+
+	// Errors.
+	_, err = Pragma(context.Background(), con, "")
+	testError(t, err, errPragma.Error(), errEmptyName.Error())
+	_, err = Pragma(context.Background(), con, "table_info", struct{}{})
+	testError(t, err, errPragma.Error(), unsupportedTypeErrMsg, indexErrMsg)
+	_, err = Pragma(context.Background(), con, "table_info", "does_not_exist")
+	require.ErrorIs(t, err, errPragma)
+	require.Equal(t, ErrorTypeCatalog, GetErrorType(err))
+	err = SetPragma(context.Background(), con, "", 1)
+	testError(t, err, errSetPragma.Error(), errEmptyName.Error())
+	err = SetPragma(context.Background(), con, "threads", math.NaN())
+	testError(t, err, errSetPragma.Error(), unsupportedTypeErrMsg)
+	err = SetPragma(context.Background(), con, "threads", "many")
+	require.ErrorIs(t, err, errSetPragma)
+}
+
+func TestPragmaLiteral(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		val  any
+		want string
+	}{
+		{nil, "NULL"},
+		{"it's", "'it''s'"},
+		{true, "true"},
+		{int8(-8), "-8"},
+		{uint64(math.MaxUint64), "18446744073709551615"},
+		{float32(0.1), "0.1"},
+		{1.5e300, "1.5e+300"},
+	}
+	for _, test := range tests {
+		literal, err := pragmaLiteral(test.val)
+		require.NoError(t, err)
+		require.Equal(t, test.want, literal)
+	}
+}
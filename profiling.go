@@ -6,7 +6,10 @@ package duckdb
 import "C"
 
 import (
+	"context"
 	"database/sql"
+	"strconv"
+	"time"
 	"unsafe"
 )
 
@@ -22,6 +25,62 @@ type ProfilingInfo struct {
 	Children []ProfilingInfo
 }
 
+// EnableProfiling enables the collection of detailed profiling information for all subsequent queries on the connection.
+// It does not print the profiling output. Use GetProfilingInfo to retrieve it.
+func EnableProfiling(c *sql.Conn) error {
+	if _, err := c.ExecContext(context.Background(), `PRAGMA enable_profiling = 'no_output'`); err != nil {
+		return err
+	}
+	_, err := c.ExecContext(context.Background(), `PRAGMA profiling_mode = 'detailed'`)
+	return err
+}
+
+// DisableProfiling disables the collection of profiling information on the connection.
+func DisableProfiling(c *sql.Conn) error {
+	_, err := c.ExecContext(context.Background(), `PRAGMA disable_profiling`)
+	return err
+}
+
+// OperatorType returns the type of an OPERATOR node, e.g., TABLE_SCAN, or QUERY_ROOT for the top-level node.
+func (info *ProfilingInfo) OperatorType() string {
+	if t, ok := info.Metrics["OPERATOR_TYPE"]; ok {
+		return t
+	}
+	return "QUERY_ROOT"
+}
+
+// Timing returns the time spent in an OPERATOR node, or the latency of the entire query for the QUERY_ROOT.
+// It returns zero, if the metric is not available.
+func (info *ProfilingInfo) Timing() time.Duration {
+	if _, ok := info.Metrics["OPERATOR_TYPE"]; ok {
+		return info.durationMetric("OPERATOR_TIMING")
+	}
+	return info.durationMetric("LATENCY")
+}
+
+// Cardinality returns the number of rows emitted by an OPERATOR node, or the number of rows returned by the query
+// for the QUERY_ROOT. It returns zero, if the metric is not available.
+func (info *ProfilingInfo) Cardinality() uint64 {
+	key := "ROWS_RETURNED"
+	if _, ok := info.Metrics["OPERATOR_TYPE"]; ok {
+		key = "OPERATOR_CARDINALITY"
+	}
+	cardinality, err := strconv.ParseUint(info.Metrics[key], 10, 64)
+	if err != nil {
+		return 0
+	}
+	return cardinality
+}
+
+func (info *ProfilingInfo) durationMetric(key string) time.Duration {
+	// DuckDB reports timings in seconds.
+	seconds, err := strconv.ParseFloat(info.Metrics[key], 64)
+	if err != nil {
+		return 0
+	}
+	return time.Duration(seconds * float64(time.Second))
+}
+
 // GetProfilingInfo obtains all available metrics set by the current connection.
 func GetProfilingInfo(c *sql.Conn) (ProfilingInfo, error) {
 	info := ProfilingInfo{}
@@ -16,18 +16,13 @@ func TestProfiling(t *testing.T) {
 	con, err := db.Conn(context.Background())
 	require.NoError(t, err)
 
-	_, err = con.ExecContext(context.Background(), `PRAGMA enable_profiling = 'no_output'`)
-	require.NoError(t, err)
-	_, err = con.ExecContext(context.Background(), `PRAGMA profiling_mode = 'detailed'`)
-	require.NoError(t, err)
+	require.NoError(t, EnableProfiling(con))
 	res, err := con.QueryContext(context.Background(), `SELECT range AS i FROM range(100) ORDER BY i`)
 	require.NoError(t, err)
 
 	info, err := GetProfilingInfo(con)
 	require.NoError(t, err)
-
-	_, err = con.ExecContext(context.Background(), `PRAGMA disable_profiling`)
-	require.NoError(t, err)
+	require.NoError(t, DisableProfiling(con))
 	require.NoError(t, res.Close())
 	require.NoError(t, con.Close())
 	require.NoError(t, db.Close())
@@ -36,6 +31,14 @@ func TestProfiling(t *testing.T) {
 	require.NotEmpty(t, info.Metrics, "metrics must not be empty")
 	require.NotEmpty(t, info.Children, "children must not be empty")
 	require.NotEmpty(t, info.Children[0].Metrics, "child metrics must not be empty")
+
+	// Verify the typed accessors.
+	require.Equal(t, "QUERY_ROOT", info.OperatorType())
+	require.Equal(t, uint64(100), info.Cardinality())
+	require.Positive(t, info.Timing())
+	require.Equal(t, "ORDER_BY", info.Children[0].OperatorType())
+	require.Equal(t, uint64(100), info.Children[0].Cardinality())
+	require.Equal(t, "TABLE_SCAN", info.Children[0].Children[0].OperatorType())
 }
 
 func TestErrProfiling(t *testing.T) {
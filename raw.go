@@ -0,0 +1,33 @@
+package duckdb
+
+import (
+	"database/sql/driver"
+	"unsafe"
+)
+
+// RawConn calls fn with the DuckDB C API handles of a DuckDB driver connection, i.e., its duckdb_database
+// and duckdb_connection, which allows calling C API functions that the driver does not wrap yet.
+// In cgo, convert the handles with (C.duckdb_connection)(unsafe.Pointer(con)).
+// The connection cannot close while fn runs, and the handles are only valid until fn returns.
+// The database handle is zero, if the connection is not (yet) part of a Connector, e.g., in the connInitFn.
+// Use sql.Conn.Raw to obtain the driver connection, which also prevents returning it to the pool while fn runs.
+// WARNING: Misusing the handles, e.g., disconnecting or closing them, or keeping them after fn returns,
+// can corrupt the connection and crash the process. fn must not close the driver connection.
+func RawConn(driverConn driver.Conn, fn func(db uintptr, con uintptr) error) error {
+	c, ok := driverConn.(*conn)
+	if !ok {
+		return getError(errInvalidCon, nil)
+	}
+
+	c.rawMu.Lock()
+	defer c.rawMu.Unlock()
+	if c.closed {
+		return getError(errClosedCon, nil)
+	}
+
+	var db uintptr
+	if c.connector != nil {
+		db = uintptr(unsafe.Pointer(c.connector.db))
+	}
+	return fn(db, uintptr(unsafe.Pointer(c.duckdbCon)))
+}
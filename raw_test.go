@@ -0,0 +1,68 @@
+package duckdb
+
+import (
+	"context"
+	"database/sql/driver"
+	"testing"
+	"time"
+	"unsafe"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestRawConn(t *testing.T) {
+	t.Parallel()
+	c, err := NewConnector("", nil)
+	require.NoError(t, err)
+	defer c.Close()
+
+	driverConn, err := c.Connect(context.Background())
+	require.NoError(t, err)
+	con := driverConn.(*conn)
+
+	err = RawConn(driverConn, func(db uintptr, rawCon uintptr) error {
+		require.Equal(t, uintptr(unsafe.Pointer(c.db)), db)
+		require.Equal(t, uintptr(unsafe.Pointer(con.duckdbCon)), rawCon)
+
+		// Execute a query with the C API on the raw connection handle.
+		other := &conn{}
+		*(*uintptr)(unsafe.Pointer(&other.duckdbCon)) = rawCon
+		rows, err := other.QueryContext(context.Background(), "SELECT 42", nil)
+		require.NoError(t, err)
+		values := make([]driver.Value, 1)
+		require.NoError(t, rows.Next(values))
+		require.Equal(t, int32(42), values[0])
+		return rows.Close()
+	})
+	require.NoError(t, err)
+
+	// Close waits until the callback returns.
+	inCallback, release := make(chan struct{}), make(chan struct{})
+	rawDone := make(chan error)
+	go func() {
+		rawDone <- RawConn(driverConn, func(uintptr, uintptr) error {
+			close(inCallback)
+			<-release
+			return nil
+		})
+	}()
+	<-inCallback
+
+	closed := make(chan error)
+	go func() {
+		closed <- driverConn.Close()
+	}()
+	select {
+	case <-closed:
+		t.Fatal("closed the connection during RawConn")
+	case <-time.After(100 * time.Millisecond):
+	}
+	close(release)
+	require.NoError(t, <-rawDone)
+	require.NoError(t, <-closed)
+
+	err = RawConn(driverConn, func(uintptr, uintptr) error { return nil })
+	require.ErrorIs(t, err, errClosedCon)
+	err = RawConn(nil, func(uintptr, uintptr) error { return nil })
+	require.ErrorIs(t, err, errInvalidCon)
+}
@@ -9,12 +9,77 @@ package duckdb
 import "C"
 
 import (
+	"context"
 	"runtime/cgo"
+	"strconv"
+	"sync/atomic"
 	"unsafe"
 )
 
+// ReplacementScanCallback returns the name and the parameters of the table function replacing tableName.
+// An empty function name keeps the table name as is.
 type ReplacementScanCallback func(tableName string) (string, []any, error)
 
+// ReplacementTableCallback returns a RowTableSource producing the rows of tableName.
+// A nil RowTableSource keeps the table name as is.
+// The ColumnInfos of the source define the column names and types of the table.
+// To produce a NULL value, FillRow sets the column value to nil.
+// The callback returns a new source for each call, as DuckDB consumes the source.
+// DuckDB calls it when resolving the table name, and again when binding the scan of the table.
+// Only the source of the second call produces rows, so a source must not acquire resources before Init.
+type ReplacementTableCallback func(tableName string) (RowTableSource, error)
+
+// replacementTableCount numbers the table functions of RegisterReplacementTable.
+var replacementTableCount atomic.Int64
+
+// RegisterReplacementTable registers a replacement scan resolving table names to Go-supplied rows.
+// For example, if cb returns a source for "my_go_data", then SELECT * FROM my_go_data scans that source.
+// Internally, it registers a table function that scans the source returned by cb.
+// Like RegisterReplacementScan, it applies to the database instance of the connector.
+func RegisterReplacementTable(connector *Connector, cb ReplacementTableCallback) error {
+	name := "go_replacement_table_" + strconv.FormatInt(replacementTableCount.Add(1), 10)
+
+	tableNameInfo, err := NewTypeInfo(TYPE_VARCHAR)
+	if err != nil {
+		return err
+	}
+	// The table function gets the table name instead of the source, so that the source only lives
+	// in the bind data of the scan. DuckDB destroys the bind data, even if binding the query fails.
+	f := RowTableFunction{
+		Config: TableFunctionConfig{
+			Arguments: []TypeInfo{tableNameInfo},
+		},
+		BindArguments: func(named map[string]any, args ...any) (RowTableSource, error) {
+			source, err := cb(args[0].(string))
+			if err == nil && source == nil {
+				err = errReplacementTableNotFound
+			}
+			return source, err
+		},
+	}
+
+	driverConn, err := connector.Connect(context.Background())
+	if err != nil {
+		return err
+	}
+	defer driverConn.Close()
+	if err = registerTableUDF(driverConn.(*conn), name, f); err != nil {
+		return err
+	}
+
+	RegisterReplacementScan(connector, func(tableName string) (string, []any, error) {
+		source, err := cb(tableName)
+		if err != nil || source == nil {
+			return "", nil, err
+		}
+		return name, []any{tableName}, nil
+	})
+	return nil
+}
+
+// RegisterReplacementScan registers a replacement scan, which replaces table names with table functions.
+// It applies to the database instance of the connector, i.e., also to other Connectors sharing the instance,
+// and it stays registered until the instance closes. See WithIsolatedInstance.
 func RegisterReplacementScan(connector *Connector, cb ReplacementScanCallback) {
 	handle := cgo.NewHandle(cb)
 	C.duckdb_add_replacement_scan(connector.db, C.duckdb_replacement_callback_t(C.replacement_scan_cb), unsafe.Pointer(&handle), C.duckdb_delete_callback_t(C.replacement_scan_destroy_data))
@@ -37,6 +102,9 @@ func replacement_scan_cb(info C.duckdb_replacement_scan_info, table_name *C.ccha
 		C.duckdb_replacement_scan_set_error(info, errStr)
 		return
 	}
+	if tFunc == "" {
+		return
+	}
 
 	fNameStr := C.CString(tFunc)
 	C.duckdb_replacement_scan_set_function_name(info, fNameStr)
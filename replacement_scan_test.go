@@ -41,3 +41,94 @@ func TestReplacementScan(t *testing.T) {
 		require.Fail(t, "expected 0, got %d", rangeRows)
 	}
 }
+
+type person struct {
+	id   int64
+	name *string
+}
+
+type personTable struct {
+	rows []person
+	idx  int
+}
+
+func (p *personTable) ColumnInfos() []ColumnInfo {
+	bigintInfo, _ := NewTypeInfo(TYPE_BIGINT)
+	varcharInfo, _ := NewTypeInfo(TYPE_VARCHAR)
+	return []ColumnInfo{{Name: "id", T: bigintInfo}, {Name: "name", T: varcharInfo}}
+}
+
+func (p *personTable) Cardinality() *CardinalityInfo {
+	return &CardinalityInfo{Cardinality: uint(len(p.rows)), Exact: true}
+}
+
+func (p *personTable) Init() {}
+
+func (p *personTable) FillRow(row Row) (bool, error) {
+	if p.idx >= len(p.rows) {
+		return false, nil
+	}
+	r := p.rows[p.idx]
+	p.idx++
+	if err := SetRowValue(row, 0, r.id); err != nil {
+		return false, err
+	}
+	if r.name == nil {
+		return true, row.SetRowValue(1, nil)
+	}
+	return true, SetRowValue(row, 1, *r.name)
+}
+
+func TestReplacementTable(t *testing.T) {
+	connector, err := NewConnector("", nil)
+	require.NoError(t, err)
+	defer connector.Close()
+
+	alice, bob := "alice", "bob"
+	people := []person{{id: 1, name: &alice}, {id: 2, name: nil}, {id: 3, name: &bob}}
+
+	err = RegisterReplacementTable(connector, func(tableName string) (RowTableSource, error) {
+		if tableName != "my_go_data" {
+			return nil, nil
+		}
+		return &personTable{rows: people}, nil
+	})
+	require.NoError(t, err)
+
+	db := sql.OpenDB(connector)
+	defer db.Close()
+
+	rows, err := db.Query(`SELECT id, name FROM my_go_data ORDER BY id`)
+	require.NoError(t, err)
+	defer rows.Close()
+
+	i := 0
+	for rows.Next() {
+		var id int64
+		var name sql.NullString
+		require.NoError(t, rows.Scan(&id, &name))
+		require.Equal(t, people[i].id, id)
+		require.Equal(t, people[i].name != nil, name.Valid)
+		if name.Valid {
+			require.Equal(t, *people[i].name, name.String)
+		}
+		i++
+	}
+	require.NoError(t, rows.Err())
+	require.Equal(t, len(people), i)
+
+	// Each scan gets a new source, and the replacement applies to projections.
+	var count int
+	require.NoError(t, db.QueryRow(`SELECT count(name) FROM my_go_data`).Scan(&count))
+	require.Equal(t, 2, count)
+
+	// A failing bind does not keep a source, and the next scan gets a new one.
+	_, err = db.Query(`SELECT missing_column FROM my_go_data`)
+	require.ErrorContains(t, err, "missing_column")
+	require.NoError(t, db.QueryRow(`SELECT count(*) FROM my_go_data`).Scan(&count))
+	require.Equal(t, 3, count)
+
+	// Other table names are not replaced.
+	_, err = db.Query(`SELECT * FROM other_data`)
+	require.ErrorContains(t, err, "other_data")
+}
@@ -2,6 +2,8 @@ package duckdb
 
 type result struct {
 	rowsAffected int64
+	// supported is false, if DuckDB does not report the number of changed rows for the statement, e.g., for DDL.
+	supported bool
 }
 
 func (r result) LastInsertId() (int64, error) {
@@ -9,5 +11,8 @@ func (r result) LastInsertId() (int64, error) {
 }
 
 func (r result) RowsAffected() (int64, error) {
+	if !r.supported {
+		return 0, ErrRowsAffectedNotSupported
+	}
 	return r.rowsAffected, nil
 }
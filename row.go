@@ -33,5 +33,5 @@ func (r Row) SetRowValue(colIdx int, val any) error {
 	if !r.IsProjected(colIdx) {
 		return nil
 	}
-	return r.chunk.SetValue(colIdx, int(r.r), val)
+	return r.chunk.SetValue(r.projection[colIdx], int(r.r), val)
 }
@@ -0,0 +1,67 @@
+package duckdb
+
+import (
+	"database/sql"
+	"reflect"
+)
+
+// RowBuffer scans the rows of a result into reusable storage, which avoids allocating
+// the destinations of Scan for each row. It is useful for generic readers that do not know the column types in advance.
+type RowBuffer struct {
+	rows *sql.Rows
+	// dest holds a pointer to the storage of each column, which we pass to Scan.
+	dest []any
+	// values is the view of the current row, which Scan returns.
+	values []any
+	// bytes holds the storage of the columns that scan into []byte, e.g., BLOB columns, and nil for other columns.
+	bytes []*sql.RawBytes
+}
+
+// NewRowBuffer returns a RowBuffer for rows. It allocates the storage of each column
+// based on its scan type, i.e., ColumnType.ScanType.
+func NewRowBuffer(rows *sql.Rows) (*RowBuffer, error) {
+	columnTypes, err := rows.ColumnTypes()
+	if err != nil {
+		return nil, err
+	}
+
+	b := &RowBuffer{
+		rows:   rows,
+		dest:   make([]any, len(columnTypes)),
+		values: make([]any, len(columnTypes)),
+		bytes:  make([]*sql.RawBytes, len(columnTypes)),
+	}
+	for i, columnType := range columnTypes {
+		if columnType.ScanType() == reflect.TypeOf([]byte{}) {
+			// Scanning into sql.RawBytes avoids copying each value into a new slice.
+			raw := &sql.RawBytes{}
+			b.bytes[i] = raw
+			b.dest[i] = raw
+			continue
+		}
+		b.dest[i] = &b.values[i]
+	}
+	return b, nil
+}
+
+// Scan scans the current row, i.e., the row after the last call to rows.Next, into the buffer.
+// It returns a view of the row, which holds one value per column, or nil for NULL values.
+// The view and the []byte values in it are only valid until the next call to Scan or rows.Next,
+// as Scan overwrites the view. Copy them to retain them, e.g., with bytes.Clone.
+// Strings and all other values are safe to retain.
+func (b *RowBuffer) Scan() ([]any, error) {
+	if err := b.rows.Scan(b.dest...); err != nil {
+		return nil, err
+	}
+	for i, raw := range b.bytes {
+		if raw == nil {
+			continue
+		}
+		if *raw == nil {
+			b.values[i] = nil
+			continue
+		}
+		b.values[i] = []byte(*raw)
+	}
+	return b.values, nil
+}
@@ -0,0 +1,106 @@
+package duckdb
+
+import (
+	"database/sql"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestRowBuffer(t *testing.T) {
+	t.Parallel()
+	db := openDB(t)
+	defer db.Close()
+
+	rows, err := db.Query(`SELECT i::BIGINT AS i, 'str' || i AS s, CASE WHEN i % 2 = 0 THEN ('b' || i)::BLOB END AS b
+		FROM range(3) t(i)`)
+	require.NoError(t, err)
+	defer rows.Close()
+
+	buf, err := NewRowBuffer(rows)
+	require.NoError(t, err)
+
+	var retained [][]any
+	for rows.Next() {
+		values, err := buf.Scan()
+		require.NoError(t, err)
+		require.Len(t, values, 3)
+
+		// Copy the view and its []byte values to retain them.
+		row := make([]any, len(values))
+		copy(row, values)
+		if b, ok := row[2].([]byte); ok {
+			row[2] = append([]byte(nil), b...)
+		}
+		retained = append(retained, row)
+	}
+	require.NoError(t, rows.Err())
+	require.Equal(t, [][]any{
+		{int64(0), "str0", []byte("b0")},
+		{int64(1), "str1", nil},
+		{int64(2), "str2", []byte("b2")},
+	}, retained)
+
+	// Scan returns the same view for each row.
+	rows, err = db.Query(`SELECT 1 UNION ALL SELECT 2`)
+	require.NoError(t, err)
+	defer rows.Close()
+	buf, err = NewRowBuffer(rows)
+	require.NoError(t, err)
+	require.True(t, rows.Next())
+	first, err := buf.Scan()
+	require.NoError(t, err)
+	require.True(t, rows.Next())
+	second, err := buf.Scan()
+	require.NoError(t, err)
+	require.Same(t, &first[0], &second[0])
+	require.False(t, rows.Next())
+}
+
+const benchmarkRowBufferQuery = `SELECT i::BIGINT, 'str' || i, ('blob' || i)::BLOB FROM range(100000) t(i)`
+
+func BenchmarkScanAllocDest(b *testing.B) {
+	db, err := sql.Open("duckdb", "")
+	require.NoError(b, err)
+	defer db.Close()
+
+	b.ReportAllocs()
+	for n := 0; n < b.N; n++ {
+		rows, err := db.Query(benchmarkRowBufferQuery)
+		require.NoError(b, err)
+		cols, err := rows.Columns()
+		require.NoError(b, err)
+
+		for rows.Next() {
+			values := make([]any, len(cols))
+			dest := make([]any, len(cols))
+			for i := range values {
+				dest[i] = &values[i]
+			}
+			require.NoError(b, rows.Scan(dest...))
+		}
+		require.NoError(b, rows.Err())
+		require.NoError(b, rows.Close())
+	}
+}
+
+func BenchmarkScanRowBuffer(b *testing.B) {
+	db, err := sql.Open("duckdb", "")
+	require.NoError(b, err)
+	defer db.Close()
+
+	b.ReportAllocs()
+	for n := 0; n < b.N; n++ {
+		rows, err := db.Query(benchmarkRowBufferQuery)
+		require.NoError(b, err)
+		buf, err := NewRowBuffer(rows)
+		require.NoError(b, err)
+
+		for rows.Next() {
+			_, err = buf.Scan()
+			require.NoError(b, err)
+		}
+		require.NoError(b, rows.Err())
+		require.NoError(b, rows.Close())
+	}
+}
@@ -6,9 +6,12 @@ package duckdb
 import "C"
 
 import (
+	"context"
+	"database/sql"
 	"database/sql/driver"
 	"fmt"
 	"io"
+	"math"
 	"math/big"
 	"reflect"
 	"strings"
@@ -19,7 +22,7 @@ import (
 // rows is a helper struct for scanning a duckdb result.
 type rows struct {
 	// stmt is a pointer to the stmt of which we are scanning the result.
-	stmt *stmt
+	stmt *Stmt
 	// res is the result of stmt.
 	res C.duckdb_result
 	// chunk holds the currently active data chunk.
@@ -30,9 +33,175 @@ type rows struct {
 	chunkIdx C.idx_t
 	// rowCount is the number of scanned rows.
 	rowCount int
+	// opts configure how the rows scan their values.
+	opts scanOptions
+	// pending holds the remaining statements of a multi-statement query, if any.
+	pending *pendingStmts
 }
 
-func newRowsWithStmt(res C.duckdb_result, stmt *stmt) *rows {
+// pendingStmts are the extracted statements of a multi-statement query.
+type pendingStmts struct {
+	ctx   context.Context
+	c     *conn
+	stmts C.duckdb_extracted_statements
+	size  C.idx_t
+	// idx is the index of the next statement to execute.
+	idx C.idx_t
+	// args are the arguments of the last statement.
+	args []driver.NamedValue
+}
+
+// nextRows executes the statements until a statement returns rows, or until executing the last statement.
+// It returns nil, if there are no more statements.
+func (p *pendingStmts) nextRows() (*rows, error) {
+	for ; p.idx < p.size; p.idx++ {
+		last := p.idx == p.size-1
+		stmt, err := p.c.prepareExtractedStmt(p.stmts, p.idx)
+		if err != nil {
+			p.idx = p.size
+			return nil, err
+		}
+
+		// Only the last statement receives the arguments.
+		var args []driver.NamedValue
+		if last {
+			args = p.args
+		}
+		res, err := stmt.execute(p.ctx, args)
+		if err != nil {
+			stmt.Close()
+			p.idx = p.size
+			return nil, err
+		}
+
+		if last || C.duckdb_result_return_type(*res) == C.DUCKDB_RESULT_TYPE_QUERY_RESULT {
+			p.idx++
+			stmt.rows = true
+			// We can't close the statement before the query result rows are closed.
+			stmt.closeOnRowsClose = true
+			return newRowsWithStmt(*res, stmt, scanOptionsFromContext(p.ctx)), nil
+		}
+
+		C.duckdb_destroy_result(res)
+		stmt.Close()
+	}
+	return nil, nil
+}
+
+// execRemaining executes the remaining statements, which nobody advanced to, so that closing the rows
+// still applies their effects, e.g., of a trailing INSERT. It skips SELECT statements, as nobody reads their results.
+func (p *pendingStmts) execRemaining() error {
+	for ; p.idx < p.size; p.idx++ {
+		stmt, err := p.c.prepareExtractedStmt(p.stmts, p.idx)
+		if err != nil {
+			p.idx = p.size
+			return err
+		}
+		if C.duckdb_prepared_statement_type(*stmt.stmt) == C.DUCKDB_STATEMENT_TYPE_SELECT {
+			stmt.Close()
+			continue
+		}
+
+		// Only the last statement receives the arguments.
+		var args []driver.NamedValue
+		if p.idx == p.size-1 {
+			args = p.args
+		}
+		res, err := stmt.execute(p.ctx, args)
+		if err != nil {
+			stmt.Close()
+			p.idx = p.size
+			return err
+		}
+		C.duckdb_destroy_result(res)
+		stmt.Close()
+	}
+	return nil
+}
+
+func (p *pendingStmts) close() {
+	C.duckdb_destroy_extracted(&p.stmts)
+}
+
+// scanOptions configure how rows scan their values. They are set via the query context,
+// except for rejectNonFinite, which is set via the Connector.
+type scanOptions struct {
+	// enumCodes is true, if ENUM values are scanned as their underlying integer codes.
+	enumCodes bool
+	// dates is true, if DATE values are scanned as Date.
+	dates bool
+	// orderedMaps is true, if MAP values are scanned as OrderedMap.
+	orderedMaps bool
+	// loc is the location of TIMESTAMP_TZ values. If nil, it is the TimeZone setting of the connection.
+	loc *time.Location
+	// nullScanTypes is true, if ColumnTypeScanType returns types that can hold NULL values.
+	nullScanTypes bool
+	// timestampMicros is true, if TIMESTAMP and TIMESTAMP_TZ values are scanned as int64 microseconds.
+	timestampMicros bool
+	// rejectNonFinite is true, if scanning NaN or infinite FLOAT and DOUBLE values fails.
+	rejectNonFinite bool
+}
+
+type (
+	enumCodesCtxKey       struct{}
+	dateTypeCtxKey        struct{}
+	orderedMapsCtxKey     struct{}
+	timeZoneCtxKey        struct{}
+	nullScanTypesCtxKey   struct{}
+	timestampMicrosCtxKey struct{}
+)
+
+// WithEnumCodes returns a copy of ctx, which makes queries executed with it return ENUM values as their
+// underlying unsigned integer codes instead of their string labels. The width of the integer depends
+// on the size of the ENUM dictionary, e.g., uint8 for up to 255 entries.
+func WithEnumCodes(ctx context.Context) context.Context {
+	return context.WithValue(ctx, enumCodesCtxKey{}, true)
+}
+
+// WithDateType returns a copy of ctx, which makes queries executed with it return DATE values as Date
+// instead of a time.Time at midnight UTC.
+func WithDateType(ctx context.Context) context.Context {
+	return context.WithValue(ctx, dateTypeCtxKey{}, true)
+}
+
+// WithOrderedMaps returns a copy of ctx, which makes queries executed with it return MAP values as OrderedMap
+// instead of Map, preserving the order of their entries.
+func WithOrderedMaps(ctx context.Context) context.Context {
+	return context.WithValue(ctx, orderedMapsCtxKey{}, true)
+}
+
+// WithTimeZone returns a copy of ctx, which makes queries executed with it return TIMESTAMP WITH TIME ZONE
+// values in loc instead of the location of the connection's TimeZone setting.
+func WithTimeZone(ctx context.Context, loc *time.Location) context.Context {
+	return context.WithValue(ctx, timeZoneCtxKey{}, loc)
+}
+
+// WithNullScanTypes returns a copy of ctx, which makes the column types of queries executed with it report
+// scan types that can hold NULL values, e.g., sql.NullInt64 instead of int64 for BIGINT columns.
+// Types without a sql.Null* equivalent are reported as pointers, e.g., *Decimal, except for []byte, *big.Int, and any.
+func WithNullScanTypes(ctx context.Context) context.Context {
+	return context.WithValue(ctx, nullScanTypesCtxKey{}, true)
+}
+
+// WithTimestampMicros returns a copy of ctx, which makes queries executed with it return TIMESTAMP and
+// TIMESTAMP_TZ values as int64 microseconds since 1970-01-01 UTC instead of a time.Time.
+// Use TimestampMicros to bind or append such values.
+func WithTimestampMicros(ctx context.Context) context.Context {
+	return context.WithValue(ctx, timestampMicrosCtxKey{}, true)
+}
+
+func scanOptionsFromContext(ctx context.Context) scanOptions {
+	var opts scanOptions
+	opts.enumCodes, _ = ctx.Value(enumCodesCtxKey{}).(bool)
+	opts.dates, _ = ctx.Value(dateTypeCtxKey{}).(bool)
+	opts.orderedMaps, _ = ctx.Value(orderedMapsCtxKey{}).(bool)
+	opts.loc, _ = ctx.Value(timeZoneCtxKey{}).(*time.Location)
+	opts.nullScanTypes, _ = ctx.Value(nullScanTypesCtxKey{}).(bool)
+	opts.timestampMicros, _ = ctx.Value(timestampMicrosCtxKey{}).(bool)
+	return opts
+}
+
+func newRowsWithStmt(res C.duckdb_result, stmt *Stmt, opts scanOptions) *rows {
 	columnCount := C.duckdb_column_count(&res)
 	r := rows{
 		res:        res,
@@ -41,7 +210,9 @@ func newRowsWithStmt(res C.duckdb_result, stmt *stmt) *rows {
 		chunkCount: C.duckdb_result_chunk_count(res),
 		chunkIdx:   0,
 		rowCount:   0,
+		opts:       opts,
 	}
+	r.opts.rejectNonFinite = stmt.c.rejectNonFinite
 
 	for i := C.idx_t(0); i < columnCount; i++ {
 		columnName := C.GoString(C.duckdb_column_name(&res, i))
@@ -54,19 +225,35 @@ func (r *rows) Columns() []string {
 	return r.chunk.columnNames
 }
 
-func (r *rows) Next(dst []driver.Value) error {
-	for r.rowCount == r.chunk.size {
-		r.chunk.close()
-		if r.chunkIdx == r.chunkCount {
-			return io.EOF
+// applyScanOptions changes the getters of the column vectors according to the scan options.
+func (r *rows) applyScanOptions() {
+	for i := range r.chunk.columns {
+		vec := &r.chunk.columns[i]
+		if r.opts.enumCodes {
+			vec.useEnumCodes()
 		}
-		data := C.duckdb_result_get_chunk(r.res, r.chunkIdx)
-		if err := r.chunk.initFromDuckDataChunk(data, false); err != nil {
-			return getError(err, nil)
+		if r.opts.dates {
+			vec.useDateType()
 		}
+		if r.opts.orderedMaps {
+			vec.useOrderedMaps()
+		}
+		if r.opts.timestampMicros {
+			vec.useTimestampMicros()
+		} else if vec.containsType(TYPE_TIMESTAMP_TZ) {
+			if r.opts.loc == nil {
+				r.opts.loc = r.stmt.c.location()
+			}
+			vec.useLocation(r.opts.loc)
+		}
+	}
+}
 
-		r.chunkIdx++
-		r.rowCount = 0
+func (r *rows) Next(dst []driver.Value) error {
+	for r.rowCount == r.chunk.size {
+		if err := r.nextChunk(); err != nil {
+			return err
+		}
 	}
 
 	columnCount := len(r.chunk.columns)
@@ -75,14 +262,70 @@ func (r *rows) Next(dst []driver.Value) error {
 		if dst[colIdx], err = r.chunk.GetValue(colIdx, r.rowCount); err != nil {
 			return err
 		}
+		if r.opts.rejectNonFinite {
+			if err = r.checkFinite(colIdx, dst[colIdx]); err != nil {
+				return err
+			}
+		}
 	}
 
 	r.rowCount++
 	return nil
 }
 
+// checkFinite returns an ErrorTypeConversion error, if val is a NaN or an infinite float.
+func (r *rows) checkFinite(colIdx int, val driver.Value) error {
+	var f float64
+	switch v := val.(type) {
+	case float32:
+		f = float64(v)
+	case float64:
+		f = v
+	default:
+		return nil
+	}
+	if !math.IsNaN(f) && !math.IsInf(f, 0) {
+		return nil
+	}
+	return &Error{
+		Type: ErrorTypeConversion,
+		Msg:  fmt.Sprintf("Conversion Error: non-finite value %v in column %q", f, r.chunk.columnNames[colIdx]),
+	}
+}
+
+// nextChunk closes the current data chunk and fetches the next data chunk of the result.
+// It returns io.EOF, if there are no more data chunks.
+func (r *rows) nextChunk() error {
+	r.chunk.close()
+	if r.chunkIdx == r.chunkCount {
+		return io.EOF
+	}
+	data := C.duckdb_result_get_chunk(r.res, r.chunkIdx)
+	if r.chunkIdx == 0 {
+		if err := r.chunk.initFromDuckDataChunk(data, false); err != nil {
+			return getError(err, nil)
+		}
+		r.applyScanOptions()
+	} else {
+		// Reuse the column state of the first chunk, e.g., the ENUM dictionaries.
+		r.chunk.resetDuckDataChunk(data, false)
+	}
+
+	r.chunkIdx++
+	r.rowCount = 0
+	return nil
+}
+
 // ColumnTypeScanType implements driver.RowsColumnTypeScanType.
 func (r *rows) ColumnTypeScanType(index int) reflect.Type {
+	t := r.scanType(index)
+	if t == nil || !r.opts.nullScanTypes {
+		return t
+	}
+	return nullScanType(t)
+}
+
+func (r *rows) scanType(index int) reflect.Type {
 	t := Type(C.duckdb_column_type(&r.res, C.idx_t(index)))
 	switch t {
 	case TYPE_INVALID:
@@ -109,37 +352,107 @@ func (r *rows) ColumnTypeScanType(index int) reflect.Type {
 		return reflect.TypeOf(float32(0))
 	case TYPE_DOUBLE:
 		return reflect.TypeOf(float64(0))
-	case TYPE_TIMESTAMP, TYPE_TIMESTAMP_S, TYPE_TIMESTAMP_MS, TYPE_TIMESTAMP_NS, TYPE_DATE, TYPE_TIME, TYPE_TIMESTAMP_TZ:
+	case TYPE_DATE:
+		if r.opts.dates {
+			return reflect.TypeOf(Date{})
+		}
+		return reflect.TypeOf(time.Time{})
+	case TYPE_TIMESTAMP, TYPE_TIMESTAMP_TZ:
+		if r.opts.timestampMicros {
+			return reflect.TypeOf(int64(0))
+		}
 		return reflect.TypeOf(time.Time{})
+	case TYPE_TIMESTAMP_S, TYPE_TIMESTAMP_MS, TYPE_TIMESTAMP_NS, TYPE_TIME:
+		return reflect.TypeOf(time.Time{})
+	case TYPE_TIME_TZ:
+		return reflect.TypeOf(TimeTZ{})
 	case TYPE_INTERVAL:
 		return reflect.TypeOf(Interval{})
-	case TYPE_HUGEINT:
+	case TYPE_HUGEINT, TYPE_UHUGEINT:
 		return reflect.TypeOf(big.NewInt(0))
-	case TYPE_VARCHAR, TYPE_ENUM:
+	case TYPE_ENUM:
+		if r.opts.enumCodes {
+			logicalType := C.duckdb_column_logical_type(&r.res, C.idx_t(index))
+			defer C.duckdb_destroy_logical_type(&logicalType)
+			return enumCodeScanType(Type(C.duckdb_enum_internal_type(logicalType)))
+		}
+		return reflect.TypeOf("")
+	case TYPE_VARCHAR:
 		return reflect.TypeOf("")
 	case TYPE_BLOB:
 		return reflect.TypeOf([]byte{})
 	case TYPE_DECIMAL:
 		return reflect.TypeOf(Decimal{})
-	case TYPE_LIST:
+	case TYPE_LIST, TYPE_ARRAY:
 		return reflect.TypeOf([]any{})
 	case TYPE_STRUCT:
 		return reflect.TypeOf(map[string]any{})
+	case TYPE_UNION:
+		return reflect.TypeOf(Union{})
 	case TYPE_MAP:
+		if r.opts.orderedMaps {
+			return reflect.TypeOf(OrderedMap{})
+		}
 		return reflect.TypeOf(Map{})
 	case TYPE_UUID:
 		return reflect.TypeOf([]byte{})
+	case TYPE_BIT:
+		return reflect.TypeOf(Bitstring{})
 	default:
 		return nil
 	}
 }
 
-// ColumnTypeDatabaseTypeName implements driver.RowsColumnTypeScanType.
+// nullScanType returns the type, which holds either a value of type t or NULL.
+func nullScanType(t reflect.Type) reflect.Type {
+	switch t {
+	case reflect.TypeOf(true):
+		return reflect.TypeOf(sql.NullBool{})
+	case reflect.TypeOf(uint8(0)):
+		return reflect.TypeOf(sql.NullByte{})
+	case reflect.TypeOf(int16(0)):
+		return reflect.TypeOf(sql.NullInt16{})
+	case reflect.TypeOf(int32(0)):
+		return reflect.TypeOf(sql.NullInt32{})
+	case reflect.TypeOf(int64(0)):
+		return reflect.TypeOf(sql.NullInt64{})
+	case reflect.TypeOf(float64(0)):
+		return reflect.TypeOf(sql.NullFloat64{})
+	case reflect.TypeOf(""):
+		return reflect.TypeOf(sql.NullString{})
+	case reflectTypeTime:
+		return reflect.TypeOf(sql.NullTime{})
+	}
+
+	// database/sql scans NULL values into pointers, interfaces, and byte slices.
+	switch {
+	case t.Kind() == reflect.Pointer, t.Kind() == reflect.Interface, t == reflect.TypeOf([]byte{}):
+		return t
+	default:
+		return reflect.PointerTo(t)
+	}
+}
+
+func enumCodeScanType(t Type) reflect.Type {
+	switch t {
+	case TYPE_UTINYINT:
+		return reflect.TypeOf(uint8(0))
+	case TYPE_USMALLINT:
+		return reflect.TypeOf(uint16(0))
+	case TYPE_UINTEGER:
+		return reflect.TypeOf(uint32(0))
+	default:
+		return reflect.TypeOf(uint64(0))
+	}
+}
+
+// ColumnTypeDatabaseTypeName implements driver.RowsColumnTypeDatabaseTypeName.
+// It returns the full DuckDB type, including its parameters, e.g., DECIMAL(18,4).
 func (r *rows) ColumnTypeDatabaseTypeName(index int) string {
 	t := Type(C.duckdb_column_type(&r.res, C.idx_t(index)))
 	switch t {
-	case TYPE_DECIMAL, TYPE_ENUM, TYPE_LIST, TYPE_STRUCT, TYPE_MAP:
-		// Only allocate the logical type if necessary.
+	case TYPE_VARCHAR, TYPE_DECIMAL, TYPE_ENUM, TYPE_LIST, TYPE_STRUCT, TYPE_MAP, TYPE_ARRAY, TYPE_UNION:
+		// Only allocate the logical type if necessary, e.g., for the JSON alias of VARCHAR.
 		logicalType := C.duckdb_column_logical_type(&r.res, C.idx_t(index))
 		defer C.duckdb_destroy_logical_type(&logicalType)
 		return logicalTypeName(logicalType)
@@ -148,7 +461,61 @@ func (r *rows) ColumnTypeDatabaseTypeName(index int) string {
 	}
 }
 
+// ColumnTypePrecisionScale implements driver.RowsColumnTypePrecisionScale.
+func (r *rows) ColumnTypePrecisionScale(index int) (precision, scale int64, ok bool) {
+	t := Type(C.duckdb_column_type(&r.res, C.idx_t(index)))
+	if t != TYPE_DECIMAL {
+		return 0, 0, false
+	}
+
+	logicalType := C.duckdb_column_logical_type(&r.res, C.idx_t(index))
+	defer C.duckdb_destroy_logical_type(&logicalType)
+	return int64(C.duckdb_decimal_width(logicalType)), int64(C.duckdb_decimal_scale(logicalType)), true
+}
+
+// HasNextResultSet implements driver.RowsNextResultSet.
+func (r *rows) HasNextResultSet() bool {
+	return r.pending != nil && r.pending.idx < r.pending.size
+}
+
+// NextResultSet implements driver.RowsNextResultSet.
+// It executes the statements of a multi-statement query until the next statement returning rows.
+func (r *rows) NextResultSet() error {
+	if !r.HasNextResultSet() {
+		return io.EOF
+	}
+	if err := r.closeResult(); err != nil {
+		return err
+	}
+
+	next, err := r.pending.nextRows()
+	if err != nil {
+		return err
+	}
+	if next == nil {
+		return io.EOF
+	}
+
+	next.pending = r.pending
+	*r = *next
+	return nil
+}
+
+// Close implements driver.Rows. It executes the remaining statements of a multi-statement query,
+// except for SELECT statements, and returns their first error.
 func (r *rows) Close() error {
+	err := r.closeResult()
+	if r.pending != nil {
+		if errExec := r.pending.execRemaining(); errExec != nil && err == nil {
+			err = errExec
+		}
+		r.pending.close()
+		r.pending = nil
+	}
+	return err
+}
+
+func (r *rows) closeResult() error {
 	r.chunk.close()
 	C.duckdb_destroy_result(&r.res)
 
@@ -164,6 +531,13 @@ func (r *rows) Close() error {
 }
 
 func logicalTypeName(logicalType C.duckdb_logical_type) string {
+	// Prefer the alias of user-defined types, if the logical type still carries it.
+	alias := C.duckdb_logical_type_get_alias(logicalType)
+	if alias != nil {
+		defer C.duckdb_free(unsafe.Pointer(alias))
+		return C.GoString(alias)
+	}
+
 	t := Type(C.duckdb_get_type_id(logicalType))
 	switch t {
 	case TYPE_DECIMAL:
@@ -177,10 +551,17 @@ func logicalTypeName(logicalType C.duckdb_logical_type) string {
 		childType := C.duckdb_list_type_child_type(logicalType)
 		defer C.duckdb_destroy_logical_type(&childType)
 		return logicalTypeName(childType) + "[]"
+	case TYPE_ARRAY:
+		childType := C.duckdb_array_type_child_type(logicalType)
+		defer C.duckdb_destroy_logical_type(&childType)
+		size := C.duckdb_array_type_array_size(logicalType)
+		return fmt.Sprintf("%s[%d]", logicalTypeName(childType), size)
 	case TYPE_STRUCT:
 		return logicalTypeNameStruct(logicalType)
 	case TYPE_MAP:
 		return logicalTypeNameMap(logicalType)
+	case TYPE_UNION:
+		return logicalTypeNameUnion(logicalType)
 	default:
 		return typeToStringMap[t]
 	}
@@ -217,6 +598,19 @@ func logicalTypeNameMap(logicalType C.duckdb_logical_type) string {
 	return fmt.Sprintf("MAP(%s, %s)", logicalTypeName(keyType), logicalTypeName(valueType))
 }
 
+func logicalTypeNameUnion(logicalType C.duckdb_logical_type) string {
+	count := int(C.duckdb_union_type_member_count(logicalType))
+	members := make([]string, count)
+	for i := 0; i < count; i++ {
+		ptrToName := C.duckdb_union_type_member_name(logicalType, C.idx_t(i))
+		memberType := C.duckdb_union_type_member_type(logicalType, C.idx_t(i))
+		members[i] = escapeStructFieldName(C.GoString(ptrToName)) + " " + logicalTypeName(memberType)
+		C.duckdb_free(unsafe.Pointer(ptrToName))
+		C.duckdb_destroy_logical_type(&memberType)
+	}
+	return "UNION(" + strings.Join(members, ", ") + ")"
+}
+
 func escapeStructFieldName(s string) string {
 	// DuckDB escapes STRUCT field names by doubling double quotes, then wrapping in double quotes.
 	return `"` + strings.ReplaceAll(s, `"`, `""`) + `"`
@@ -0,0 +1,238 @@
+package duckdb
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/mitchellh/mapstructure"
+)
+
+// Querier executes queries returning rows, e.g., *sql.DB, *sql.Conn, or *sql.Tx.
+type Querier interface {
+	QueryContext(ctx context.Context, query string, args ...any) (*sql.Rows, error)
+}
+
+// Select executes the query with q and scans all result rows into dest, which must be a pointer to a slice of
+// structs or struct pointers. Select replaces the elements of the slice.
+//
+// It maps each column to the exported field whose `db` tag equals the column name, or, for fields without a tag,
+// whose name equals the column name, ignoring case. A `db:"-"` tag skips the field. Fields of embedded structs
+// count as fields of the struct. A column without a field fails with an error, while fields without a column
+// keep their zero values. Select scans each value directly into its field, so a field of the column's
+// ColumnType.ScanType, or a pointer to it for NULL values, avoids any conversion.
+func Select(ctx context.Context, q Querier, dest any, query string, args ...any) error {
+	slice := reflect.ValueOf(dest)
+	if slice.Kind() != reflect.Pointer || slice.IsNil() || slice.Elem().Kind() != reflect.Slice {
+		return getError(errSelectDest, nil)
+	}
+	slice = slice.Elem()
+	elemType := slice.Type().Elem()
+	structType := elemType
+	if structType.Kind() == reflect.Pointer {
+		structType = structType.Elem()
+	}
+	if structType.Kind() != reflect.Struct {
+		return getError(errSelectDest, nil)
+	}
+
+	rows, err := q.QueryContext(ctx, query, args...)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	columns, err := rows.Columns()
+	if err != nil {
+		return err
+	}
+	fields, err := selectFields(structType, columns)
+	if err != nil {
+		return err
+	}
+
+	// database/sql cannot scan into []rune fields, so we scan them via Runes.
+	runeFields := make([]bool, len(fields))
+	for i, index := range fields {
+		runeFields[i] = isRuneSlice(structType.FieldByIndex(index).Type)
+	}
+
+	scanDest := make([]any, len(columns))
+	slice.SetLen(0)
+	for rows.Next() {
+		// Scan into the new element of the slice, instead of copying the struct into it afterward.
+		slice.Set(reflect.Append(slice, reflect.Zero(elemType)))
+		elem := slice.Index(slice.Len() - 1)
+		if elemType.Kind() == reflect.Pointer {
+			elem.Set(reflect.New(structType))
+			elem = elem.Elem()
+		}
+
+		for i, index := range fields {
+			field := elem.FieldByIndex(index).Addr()
+			if runeFields[i] {
+				field = field.Convert(reflectTypeRunesPointer)
+			}
+			scanDest[i] = field.Interface()
+		}
+		if err = rows.Scan(scanDest...); err != nil {
+			return err
+		}
+	}
+	if err = rows.Err(); err != nil {
+		return err
+	}
+	return rows.Close()
+}
+
+// selectFields returns the index of the struct field of each column.
+func selectFields(structType reflect.Type, columns []string) ([][]int, error) {
+	fieldsByName := make(map[string][]int)
+	for _, field := range reflect.VisibleFields(structType) {
+		if !field.IsExported() || field.Anonymous && field.Type.Kind() == reflect.Struct {
+			continue
+		}
+		if !reachable(structType, field.Index) {
+			continue
+		}
+
+		name, ok := field.Tag.Lookup("db")
+		if name == "-" {
+			continue
+		}
+		if !ok || name == "" {
+			name = field.Name
+		}
+		name = strings.ToLower(name)
+		if _, ok = fieldsByName[name]; ok {
+			return nil, getError(errSelectDupField, fmt.Errorf("%q in %s", name, structType))
+		}
+		fieldsByName[name] = field.Index
+	}
+
+	fields := make([][]int, len(columns))
+	for i, column := range columns {
+		index, ok := fieldsByName[strings.ToLower(column)]
+		if !ok {
+			return nil, getError(errSelectNoField, fmt.Errorf("%q in %s", column, structType))
+		}
+		fields[i] = index
+	}
+	return fields, nil
+}
+
+var (
+	reflectTypeRunesPointer = reflect.TypeOf((*Runes)(nil))
+	reflectTypeScanner      = reflect.TypeOf((*sql.Scanner)(nil)).Elem()
+)
+
+// isRuneSlice returns true, if t is a []rune, or a type with the underlying type []rune, which is not a sql.Scanner.
+func isRuneSlice(t reflect.Type) bool {
+	p := reflect.PointerTo(t)
+	return t.Kind() == reflect.Slice && p.ConvertibleTo(reflectTypeRunesPointer) && !p.Implements(reflectTypeScanner)
+}
+
+// reachable returns false, if the field with the index path is a field of an embedded struct pointer,
+// as FieldByIndex cannot reach it without allocating the embedded struct.
+func reachable(structType reflect.Type, index []int) bool {
+	t := structType
+	for _, i := range index[:len(index)-1] {
+		t = t.Field(i).Type
+		if t.Kind() == reflect.Pointer {
+			return false
+		}
+	}
+	return true
+}
+
+// StructListOption configures ScanStructList.
+type StructListOption func(opts *structListOptions)
+
+type structListOptions struct {
+	// nullAsZero is true, if NULL elements result in zero values instead of an error.
+	nullAsZero bool
+}
+
+// WithNullElementsAsZero makes ScanStructList return the zero value of T for NULL elements of the list.
+// By default, a NULL element fails with an error.
+func WithNullElementsAsZero() StructListOption {
+	return func(opts *structListOptions) {
+		opts.nullAsZero = true
+	}
+}
+
+// ScanStructList scans the LIST(STRUCT(...)) column with index col of the current row of rows into a slice of
+// structs, e.g., the result of list(struct_pack(...)). Call it after rows.Next, like rows.Scan.
+// A NULL list results in a nil slice.
+//
+// It maps each struct entry to the field whose `db` tag equals the entry name, or, for fields without a tag,
+// whose name equals the entry name, ignoring case. A `db:"-"` tag skips the field. An entry without a field fails
+// with an error, while fields without an entry keep their zero values. Values convert to the type of their field,
+// e.g., a BIGINT entry to an int field, and nested lists and structs to slices and structs.
+func ScanStructList[T any](rows *sql.Rows, col int, opts ...StructListOption) ([]T, error) {
+	var options structListOptions
+	for _, opt := range opts {
+		opt(&options)
+	}
+
+	var zero T
+	if reflect.TypeOf(zero) == nil || reflect.TypeOf(zero).Kind() != reflect.Struct {
+		return nil, getError(errScanStructListType, nil)
+	}
+
+	columns, err := rows.Columns()
+	if err != nil {
+		return nil, err
+	}
+	if col < 0 || col >= len(columns) {
+		return nil, getError(columnError(errScanStructListCol, col), nil)
+	}
+
+	// Scan all columns, as Scan requires a destination for each column.
+	values := make([]any, len(columns))
+	dest := make([]any, len(columns))
+	for i := range values {
+		dest[i] = &values[i]
+	}
+	if err = rows.Scan(dest...); err != nil {
+		return nil, err
+	}
+
+	if values[col] == nil {
+		return nil, nil
+	}
+	list, ok := values[col].([]any)
+	if !ok {
+		err = castError(reflect.TypeOf(values[col]).String(), reflect.TypeOf([]T{}).String())
+		return nil, getError(errScanStructList, columnError(err, col))
+	}
+
+	res := make([]T, len(list))
+	for i, elem := range list {
+		if elem == nil {
+			if options.nullAsZero {
+				continue
+			}
+			return nil, getError(addIndexToError(errScanStructListNull, i), nil)
+		}
+		if err = decodeStruct(elem, &res[i]); err != nil {
+			return nil, getError(errScanStructList, addIndexToError(err, i))
+		}
+	}
+	return res, nil
+}
+
+// decodeStruct decodes a STRUCT value into the struct pointed to by dest.
+func decodeStruct(value any, dest any) error {
+	decoder, err := mapstructure.NewDecoder(&mapstructure.DecoderConfig{
+		TagName:     "db",
+		ErrorUnused: true,
+		Result:      dest,
+	})
+	if err != nil {
+		return err
+	}
+	return decoder.Decode(value)
+}
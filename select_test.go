@@ -0,0 +1,190 @@
+package duckdb
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+type selectBase struct {
+	ID int64 `db:"id"`
+}
+
+type selectRow struct {
+	selectBase
+	Name       string
+	Score      float64   `db:"score"`
+	Tags       []any     `db:"tags"`
+	CreatedAt  time.Time `db:"created_at"`
+	Comment    *string   `db:"comment"`
+	Ignored    string    `db:"-"`
+	unexported int
+}
+
+func TestSelect(t *testing.T) {
+	t.Parallel()
+	db := openDB(t)
+	defer db.Close()
+
+	_, err := db.Exec(`CREATE TABLE people (id BIGINT, name VARCHAR, score DOUBLE, tags VARCHAR[], created_at TIMESTAMP, comment VARCHAR)`)
+	require.NoError(t, err)
+	_, err = db.Exec(`INSERT INTO people VALUES
+		(1, 'duck', 1.5, ['a', 'b'], '2024-01-02 03:04:05', 'quack'),
+		(2, 'goose', -2, [], '2024-02-03 04:05:06', NULL)`)
+	require.NoError(t, err)
+
+	const query = `SELECT id, name AS "NAME", score, tags, created_at, comment FROM people WHERE id >= ? ORDER BY id`
+	comment := "quack"
+	expected := []selectRow{
+		{selectBase{1}, "duck", 1.5, []any{"a", "b"}, time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC), &comment, "", 0},
+		{selectBase{2}, "goose", -2, []any{}, time.Date(2024, 2, 3, 4, 5, 6, 0, time.UTC), nil, "", 0},
+	}
+
+	// Select replaces the elements of the slice.
+	res := []selectRow{{Name: "stale"}}
+	require.NoError(t, Select(context.Background(), db, &res, query, 1))
+	require.Equal(t, expected, res)
+
+	var ptrs []*selectRow
+	con, err := db.Conn(context.Background())
+	require.NoError(t, err)
+	require.NoError(t, Select(context.Background(), con, &ptrs, query, 2))
+	require.Equal(t, []*selectRow{&expected[1]}, ptrs)
+	require.NoError(t, con.Close())
+
+	// An empty result.
+	require.NoError(t, Select(context.Background(), db, &res, query, 3))
+	require.Empty(t, res)
+
+	// Errors.
+	err = Select(context.Background(), db, &res, `SELECT 1 AS id, 2 AS extra`)
+	testError(t, err, errSelectNoField.Error(), `"extra"`)
+	err = Select(context.Background(), db, res, query, 1)
+	testError(t, err, errSelectDest.Error())
+	var ints []int
+	err = Select(context.Background(), db, &ints, `SELECT 1`)
+	testError(t, err, errSelectDest.Error())
+	err = Select(context.Background(), db, &res, `SELECT 'x' AS id`)
+	require.ErrorContains(t, err, `column index 0, name "id"`)
+	err = Select(context.Background(), db, &res, `SELECT * FROM does_not_exist`)
+	require.Equal(t, ErrorTypeCatalog, GetErrorType(err))
+
+	type dup struct {
+		A int `db:"x"`
+		B int `db:"X"`
+	}
+	var dups []dup
+	err = Select(context.Background(), db, &dups, `SELECT 1 AS x`)
+	testError(t, err, errSelectDupField.Error())
+
+	var tx *sql.Tx
+	tx, err = db.Begin()
+	require.NoError(t, err)
+	require.NoError(t, Select(context.Background(), tx, &res, query, 1))
+	require.Len(t, res, 2)
+	require.NoError(t, tx.Commit())
+
+	// Select scans into []rune fields via Runes, and into named string types.
+	type text string
+	type runes []rune
+	type textRow struct {
+		Text  text
+		Runes []rune
+		Named runes
+		Ptr   *text
+	}
+	var texts []textRow
+	require.NoError(t, Select(context.Background(), db, &texts, `SELECT 'a' AS text, 'ü🦆' AS runes, 'b' AS named, NULL AS ptr`))
+	require.Equal(t, []textRow{{Text: "a", Runes: []rune("ü🦆"), Named: runes("b")}}, texts)
+}
+
+type structListOrder struct {
+	ID      int       `db:"order_id"`
+	Item    string    `db:"item"`
+	Qty     int32     `db:"qty"`
+	Tags    []string  `db:"tags"`
+	Shipped time.Time `db:"shipped"`
+	Note    *string   `db:"note"`
+}
+
+func TestScanStructList(t *testing.T) {
+	t.Parallel()
+	db := openDB(t)
+	defer db.Close()
+
+	_, err := db.Exec(`CREATE TABLE orders (customer VARCHAR, order_id BIGINT, item VARCHAR, qty INTEGER, shipped DATE);
+		INSERT INTO orders VALUES
+			('duck', 1, 'bread', 2, '2024-01-02'),
+			('duck', 2, 'seeds', 5, '2024-01-03'),
+			('goose', 3, 'grass', 1, '2024-02-01')`)
+	require.NoError(t, err)
+
+	// Aggregate the orders of each customer into a list of structs.
+	rows, err := db.Query(`
+		SELECT customer, list(struct_pack(order_id, item, qty, tags := [item], shipped, note := NULL::VARCHAR) ORDER BY order_id)
+		FROM orders
+		GROUP BY customer
+		ORDER BY customer`)
+	require.NoError(t, err)
+
+	var orders [][]structListOrder
+	for rows.Next() {
+		res, err := ScanStructList[structListOrder](rows, 1)
+		require.NoError(t, err)
+		orders = append(orders, res)
+	}
+	require.NoError(t, rows.Err())
+	require.NoError(t, rows.Close())
+
+	date := func(month time.Month, day int) time.Time {
+		return time.Date(2024, month, day, 0, 0, 0, 0, time.UTC)
+	}
+	require.Equal(t, [][]structListOrder{
+		{
+			{ID: 1, Item: "bread", Qty: 2, Tags: []string{"bread"}, Shipped: date(time.January, 2)},
+			{ID: 2, Item: "seeds", Qty: 5, Tags: []string{"seeds"}, Shipped: date(time.January, 3)},
+		},
+		{
+			{ID: 3, Item: "grass", Qty: 1, Tags: []string{"grass"}, Shipped: date(time.February, 1)},
+		},
+	}, orders)
+
+	scanOne := func(query string, col int, opts ...StructListOption) ([]structListOrder, error) {
+		rows, err := db.Query(query)
+		require.NoError(t, err)
+		defer rows.Close()
+		require.True(t, rows.Next())
+		return ScanStructList[structListOrder](rows, col, opts...)
+	}
+
+	// A NULL list results in a nil slice.
+	res, err := scanOne(`SELECT NULL::STRUCT(order_id BIGINT)[]`, 0)
+	require.NoError(t, err)
+	require.Nil(t, res)
+
+	// NULL elements fail, unless they result in zero values.
+	const nullQuery = `SELECT [{'order_id': 1, 'item': 'a'}, NULL]`
+	_, err = scanOne(nullQuery, 0)
+	testError(t, err, errScanStructListNull.Error(), indexErrMsg+": 1")
+	res, err = scanOne(nullQuery, 0, WithNullElementsAsZero())
+	require.NoError(t, err)
+	require.Equal(t, []structListOrder{{ID: 1, Item: "a"}, {}}, res)
+
+	// Entries without a field, other columns, and invalid column indexes fail.
+	_, err = scanOne(`SELECT [{'order_id': 1, 'unknown': 2}]`, 0)
+	testError(t, err, errScanStructList.Error(), "unknown")
+	_, err = scanOne(`SELECT 42`, 0)
+	testError(t, err, errScanStructList.Error(), castErrMsg)
+	_, err = scanOne(`SELECT 42`, 1)
+	testError(t, err, errScanStructListCol.Error())
+
+	rows, err = db.Query(`SELECT 42`)
+	require.NoError(t, err)
+	require.True(t, rows.Next())
+	_, err = ScanStructList[int](rows, 0)
+	testError(t, err, errScanStructListType.Error())
+	require.NoError(t, rows.Close())
+}
@@ -0,0 +1,67 @@
+package duckdb
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+)
+
+// Setting returns the current value of the setting with the given name, e.g., threads, as a string.
+// An unknown setting returns the DuckDB error, which is an ErrorTypeCatalog error.
+func Setting(c *sql.Conn, name string) (string, error) {
+	if name == "" {
+		return "", getError(errGetSetting, errEmptyName)
+	}
+
+	var value sql.NullString
+	row := c.QueryRowContext(context.Background(), `SELECT current_setting($1)::VARCHAR`, name)
+	if err := row.Scan(&value); err != nil {
+		return "", getError(errGetSetting, err)
+	}
+	return value.String, nil
+}
+
+// SetSetting sets the setting with the given name to value, e.g., SetSetting(c, "threads", "4").
+// DuckDB casts the value to the type of the setting. An invalid value returns an ErrorTypeSettings error,
+// and an unknown setting returns the DuckDB error, which is an ErrorTypeCatalog error.
+// NOTE: Some settings, e.g., threads, apply to the whole database instead of only the connection.
+func SetSetting(c *sql.Conn, name string, value string) error {
+	if name == "" {
+		return getError(errSetSetting, errEmptyName)
+	}
+
+	query := "SET " + quoteIdentifier(name) + " = " + quoteString(value)
+	if _, err := c.ExecContext(context.Background(), query); err != nil {
+		return getError(errSetSetting, settingsError(err))
+	}
+	return nil
+}
+
+// SetSeed sets the seed of the random number generator of the connection, e.g., for reproducible results of random().
+// The seed must be in the range [-1, 1], otherwise SetSeed returns an ErrorTypeInvalidInput error.
+// The seed only applies to the connection. Sampling, e.g., USING SAMPLE, has its own seed,
+// which you can fix with the REPEATABLE clause.
+func SetSeed(c *sql.Conn, seed float64) error {
+	if !(seed >= -1 && seed <= 1) {
+		return getError(errSetSeed, &Error{
+			Type: ErrorTypeInvalidInput,
+			Msg:  fmt.Sprintf("Invalid Input Error: seed %v is out of range [-1, 1]", seed),
+		})
+	}
+	if _, err := c.ExecContext(context.Background(), `SELECT setseed($1)`, seed); err != nil {
+		return getError(errSetSeed, err)
+	}
+	return nil
+}
+
+// settingsError classifies the failures of SET statements as settings errors,
+// as DuckDB reports invalid values with various error types, e.g., ErrorTypeInvalidInput or ErrorTypeParser.
+// It preserves catalog errors of unknown settings.
+func settingsError(err error) error {
+	var dbErr *Error
+	if errors.As(err, &dbErr) && dbErr.Type != ErrorTypeCatalog {
+		return &Error{Type: ErrorTypeSettings, Msg: dbErr.Msg}
+	}
+	return err
+}
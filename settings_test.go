@@ -0,0 +1,87 @@
+package duckdb
+
+import (
+	"context"
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestSetting(t *testing.T) {
+	t.Parallel()
+	db := openDB(t)
+	defer db.Close()
+
+	con, err := db.Conn(context.Background())
+	require.NoError(t, err)
+	defer con.Close()
+
+	require.NoError(t, SetSetting(con, "threads", "3"))
+	value, err := Setting(con, "threads")
+	require.NoError(t, err)
+	require.Equal(t, "3", value)
+
+	require.NoError(t, SetSetting(con, "default_order", "desc"))
+	value, err = Setting(con, "default_order")
+	require.NoError(t, err)
+	require.Equal(t, "desc", value)
+
+	// Invalid values are settings errors.
+	err = SetSetting(con, "threads", "many")
+	require.ErrorIs(t, err, errSetSetting)
+	require.Equal(t, ErrorTypeSettings, GetErrorType(err))
+	err = SetSetting(con, "memory_limit", "lots")
+	require.Equal(t, ErrorTypeSettings, GetErrorType(err))
+
+	// Unknown settings preserve the DuckDB error type.
+	err = SetSetting(con, "does_not_exist", "1")
+	require.ErrorIs(t, err, errSetSetting)
+	require.Equal(t, ErrorTypeCatalog, GetErrorType(err))
+	require.ErrorContains(t, err, "does_not_exist")
+	_, err = Setting(con, "does_not_exist")
+	require.ErrorIs(t, err, errGetSetting)
+	require.Equal(t, ErrorTypeCatalog, GetErrorType(err))
+
+	require.ErrorIs(t, SetSetting(con, "", "1"), errEmptyName)
+	_, err = Setting(con, "")
+	require.ErrorIs(t, err, errEmptyName)
+}
+
+func TestSetSeed(t *testing.T) {
+	t.Parallel()
+	db := openDB(t)
+	defer db.Close()
+
+	con, err := db.Conn(context.Background())
+	require.NoError(t, err)
+	defer con.Close()
+
+	randoms := func() []float64 {
+		rows, err := con.QueryContext(context.Background(), `SELECT random() FROM range(5)`)
+		require.NoError(t, err)
+		var res []float64
+		for rows.Next() {
+			var r float64
+			require.NoError(t, rows.Scan(&r))
+			res = append(res, r)
+		}
+		require.NoError(t, rows.Err())
+		require.NoError(t, rows.Close())
+		return res
+	}
+
+	// The same seed produces the same random numbers.
+	require.NoError(t, SetSeed(con, 0.42))
+	first := randoms()
+	require.NoError(t, SetSeed(con, 0.42))
+	require.Equal(t, first, randoms())
+	require.NoError(t, SetSeed(con, -0.42))
+	require.NotEqual(t, first, randoms())
+
+	for _, seed := range []float64{1.5, -1.01, math.NaN(), math.Inf(1)} {
+		err = SetSeed(con, seed)
+		require.ErrorIs(t, err, errSetSeed)
+		require.Equal(t, ErrorTypeInvalidInput, GetErrorType(err))
+	}
+}
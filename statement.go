@@ -11,19 +11,30 @@ import (
 	"errors"
 	"fmt"
 	"math/big"
+	"reflect"
+	"strconv"
 	"time"
 	"unsafe"
 )
 
-type stmt struct {
+// Stmt implements the driver.Stmt interface.
+// You can obtain a *Stmt by type-asserting the driver.Stmt returned by preparing a statement on a driver connection.
+//
+// A Stmt keeps its DuckDB prepared statement until Close. Each execution rebinds the arguments to it,
+// without preparing the query again. Thus, a *sql.Stmt from Prepare reuses the prepared statement of each
+// connection it ran on, and releases them when calling sql.Stmt.Close.
+type Stmt struct {
 	c                *conn
 	stmt             *C.duckdb_prepared_statement
 	closeOnRowsClose bool
 	closed           bool
 	rows             bool
+	// query is the text of a statement prepared via Prepare. The query logger only logs statements with a query text,
+	// as the connection logs the queries of its internal statements.
+	query string
 }
 
-func (s *stmt) Close() error {
+func (s *Stmt) Close() error {
 	if s.rows {
 		panic("database/sql/driver: misuse of duckdb driver: Close with active Rows")
 	}
@@ -36,27 +47,116 @@ func (s *stmt) Close() error {
 	return nil
 }
 
-func (s *stmt) NumInput() int {
+// NumInput implements driver.Stmt. It returns the number of parameters of the prepared statement,
+// so that database/sql checks the number of arguments. For named parameters, e.g., $foo, it returns -1,
+// which skips the check, as named arguments bind by name instead of by position.
+func (s *Stmt) NumInput() int {
 	if s.closed {
 		panic("database/sql/driver: misuse of duckdb driver: NumInput after Close")
 	}
-	paramCount := C.duckdb_nparams(*s.stmt)
-	return int(paramCount)
+	count := s.paramCount()
+	for i := 1; i <= count; i++ {
+		if s.namedParam(i) {
+			return -1
+		}
+	}
+	return count
+}
+
+// paramCount returns the number of (distinct) parameters of the prepared statement.
+func (s *Stmt) paramCount() int {
+	return int(C.duckdb_nparams(*s.stmt))
+}
+
+// namedParam returns true, if the parameter with index n has a name, i.e., if it is not a ? or $n parameter.
+func (s *Stmt) namedParam(n int) bool {
+	cName := C.duckdb_parameter_name(*s.stmt, C.idx_t(n))
+	if cName == nil {
+		return false
+	}
+	defer C.duckdb_free(unsafe.Pointer(cName))
+
+	_, err := strconv.Atoi(C.GoString(cName))
+	return err != nil
+}
+
+// ParamTypes returns the Type of each parameter of the prepared statement.
+// The parameter with index 1 is at position 0 of the returned slice.
+// It returns an error, if DuckDB could not resolve the type of any of the parameters.
+// NOTE: DuckDB does not (yet) resolve the types of named parameters.
+func (s *Stmt) ParamTypes() ([]Type, error) {
+	if s.closed {
+		return nil, getError(errClosedStmt, nil)
+	}
+
+	types := make([]Type, s.paramCount())
+	for i := range types {
+		t := Type(C.duckdb_param_type(*s.stmt, C.idx_t(i+1)))
+		if t == TYPE_INVALID {
+			return nil, getError(errAPI, addIndexToError(errUnresolvedParamType, i+1))
+		}
+		types[i] = t
+	}
+	return types, nil
 }
 
-func (s *stmt) bind(args []driver.NamedValue) error {
-	if s.NumInput() > len(args) {
-		return fmt.Errorf("incorrect argument count for command: have %d want %d", len(args), s.NumInput())
+// ParamIndex returns the index of the (named) parameter with the given name.
+// For example, for the statement `SELECT $foo, $bar`, ParamIndex("bar") returns 2.
+func (s *Stmt) ParamIndex(name string) (int, error) {
+	if s.closed {
+		return 0, getError(errClosedStmt, nil)
+	}
+
+	cName := C.CString(name)
+	defer C.duckdb_free(unsafe.Pointer(cName))
+
+	var idx C.idx_t
+	if state := C.duckdb_bind_parameter_index(*s.stmt, &idx, cName); state == C.DuckDBError {
+		return 0, getError(errAPI, paramNotFoundError(name))
+	}
+	return int(idx), nil
+}
+
+func (s *Stmt) bind(args []driver.NamedValue) error {
+	if s.paramCount() > len(args) {
+		return fmt.Errorf("incorrect argument count for command: have %d want %d", len(args), s.paramCount())
+	}
+
+	// Clear the arguments of the previous execution, so that none of them leak into this execution.
+	if state := C.duckdb_clear_bindings(*s.stmt); state == C.DuckDBError {
+		return errCouldNotBind
 	}
 
 	// FIXME (feature): we can't pass nested types as parameters (bind_value) yet
 
-	// relaxed length check allow for unused parameters.
-	for i := 0; i < s.NumInput(); i++ {
-		name := C.duckdb_parameter_name(*s.stmt, C.idx_t(i+1))
-		paramName := C.GoString(name)
-		C.duckdb_free(unsafe.Pointer(name))
+	named, positional := false, false
+	for _, arg := range args {
+		if arg.Name != "" {
+			named = true
+		} else {
+			positional = true
+		}
+	}
+	if named && positional {
+		return getError(errCouldNotBind, errMixedParams)
+	}
+
+	// Resolve named parameters to their index.
+	if named {
+		for _, arg := range args {
+			n, err := s.ParamIndex(arg.Name)
+			if err != nil {
+				return err
+			}
+			if err = s.bindValue(arg.Value, n); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
 
+	// relaxed length check allow for unused parameters.
+	for i := 0; i < s.paramCount(); i++ {
 		// fallback on index position
 		arg := args[i]
 
@@ -67,107 +167,229 @@ func (s *stmt) bind(args []driver.NamedValue) error {
 			}
 		}
 
-		// override with name if set
-		for _, v := range args {
-			if v.Name == paramName {
-				arg = v
-			}
+		if err := s.bindValue(arg.Value, i+1); err != nil {
+			return err
 		}
+	}
 
-		switch v := arg.Value.(type) {
-		case bool:
-			if rv := C.duckdb_bind_boolean(*s.stmt, C.idx_t(i+1), C.bool(v)); rv == C.DuckDBError {
-				return errCouldNotBind
-			}
-		case int8:
-			if rv := C.duckdb_bind_int8(*s.stmt, C.idx_t(i+1), C.int8_t(v)); rv == C.DuckDBError {
-				return errCouldNotBind
-			}
-		case int16:
-			if rv := C.duckdb_bind_int16(*s.stmt, C.idx_t(i+1), C.int16_t(v)); rv == C.DuckDBError {
-				return errCouldNotBind
-			}
-		case int32:
-			if rv := C.duckdb_bind_int32(*s.stmt, C.idx_t(i+1), C.int32_t(v)); rv == C.DuckDBError {
-				return errCouldNotBind
-			}
-		case int64:
-			if rv := C.duckdb_bind_int64(*s.stmt, C.idx_t(i+1), C.int64_t(v)); rv == C.DuckDBError {
-				return errCouldNotBind
-			}
-		case int:
-			if rv := C.duckdb_bind_int64(*s.stmt, C.idx_t(i+1), C.int64_t(v)); rv == C.DuckDBError {
-				return errCouldNotBind
-			}
-		case *big.Int:
-			val, err := hugeIntFromNative(v)
+	return nil
+}
+
+// bindTimestamp binds a time.Time according to the precision of the parameter.
+// It truncates the time to the precision of TIMESTAMP_S, TIMESTAMP_MS, and TIMESTAMP (microseconds) parameters.
+// A TIMESTAMP_TZ parameter receives the instant of the time, regardless of its location and the TimeZone setting.
+func (s *Stmt) bindTimestamp(v time.Time, n int) error {
+	switch Type(C.duckdb_param_type(*s.stmt, C.idx_t(n))) {
+	case TYPE_TIMESTAMP_TZ:
+		// Binding a TIMESTAMP would cast it to TIMESTAMP_TZ in the TimeZone setting.
+		val := C.duckdb_timestamp{micros: C.int64_t(v.UnixMicro())}
+		if rv := C.duckdb_bind_timestamp_tz(*s.stmt, C.idx_t(n), val); rv == C.DuckDBError {
+			return errCouldNotBind
+		}
+		return nil
+	case TYPE_TIMESTAMP_NS:
+		// duckdb_timestamp holds microseconds. To keep the nanoseconds, we bind a string,
+		// which DuckDB casts to TIMESTAMP_NS without loss.
+		val := C.CString(v.UTC().Format("2006-01-02 15:04:05.999999999"))
+		defer C.duckdb_free(unsafe.Pointer(val))
+		if rv := C.duckdb_bind_varchar(*s.stmt, C.idx_t(n), val); rv == C.DuckDBError {
+			return errCouldNotBind
+		}
+		return nil
+	case TYPE_TIMESTAMP_MS:
+		// DuckDB rounds when casting to a lower precision, whereas the appender truncates.
+		v = v.Truncate(time.Millisecond)
+	case TYPE_TIMESTAMP_S:
+		v = v.Truncate(time.Second)
+	}
+
+	val := C.duckdb_timestamp{
+		micros: C.int64_t(v.UTC().UnixMicro()),
+	}
+	if rv := C.duckdb_bind_timestamp(*s.stmt, C.idx_t(n), val); rv == C.DuckDBError {
+		return errCouldNotBind
+	}
+	return nil
+}
+
+// bindValue binds val to the parameter with index n.
+func (s *Stmt) bindValue(val any, n int) error {
+	switch v := val.(type) {
+	case bool:
+		if rv := C.duckdb_bind_boolean(*s.stmt, C.idx_t(n), C.bool(v)); rv == C.DuckDBError {
+			return errCouldNotBind
+		}
+	case int8:
+		if rv := C.duckdb_bind_int8(*s.stmt, C.idx_t(n), C.int8_t(v)); rv == C.DuckDBError {
+			return errCouldNotBind
+		}
+	case int16:
+		if rv := C.duckdb_bind_int16(*s.stmt, C.idx_t(n), C.int16_t(v)); rv == C.DuckDBError {
+			return errCouldNotBind
+		}
+	case int32:
+		if rv := C.duckdb_bind_int32(*s.stmt, C.idx_t(n), C.int32_t(v)); rv == C.DuckDBError {
+			return errCouldNotBind
+		}
+	case int64:
+		if rv := C.duckdb_bind_int64(*s.stmt, C.idx_t(n), C.int64_t(v)); rv == C.DuckDBError {
+			return errCouldNotBind
+		}
+	case int:
+		if rv := C.duckdb_bind_int64(*s.stmt, C.idx_t(n), C.int64_t(v)); rv == C.DuckDBError {
+			return errCouldNotBind
+		}
+	case TimestampMicros:
+		val := C.duckdb_timestamp{micros: C.int64_t(v)}
+		if rv := C.duckdb_bind_timestamp(*s.stmt, C.idx_t(n), val); rv == C.DuckDBError {
+			return errCouldNotBind
+		}
+	case *big.Int:
+		// Bind values exceeding the HUGEINT range as UHUGEINT.
+		if v.Sign() > 0 && v.BitLen() == 128 {
+			val, err := uhugeIntFromNative(v)
 			if err != nil {
 				return err
 			}
-			if rv := C.duckdb_bind_hugeint(*s.stmt, C.idx_t(i+1), val); rv == C.DuckDBError {
-				return errCouldNotBind
-			}
-		case uint8:
-			if rv := C.duckdb_bind_uint8(*s.stmt, C.idx_t(i+1), C.uchar(v)); rv == C.DuckDBError {
-				return errCouldNotBind
-			}
-		case uint16:
-			if rv := C.duckdb_bind_uint16(*s.stmt, C.idx_t(i+1), C.uint16_t(v)); rv == C.DuckDBError {
-				return errCouldNotBind
-			}
-		case uint32:
-			if rv := C.duckdb_bind_uint32(*s.stmt, C.idx_t(i+1), C.uint32_t(v)); rv == C.DuckDBError {
-				return errCouldNotBind
-			}
-		case uint64:
-			if rv := C.duckdb_bind_uint64(*s.stmt, C.idx_t(i+1), C.uint64_t(v)); rv == C.DuckDBError {
-				return errCouldNotBind
-			}
-		case float32:
-			if rv := C.duckdb_bind_float(*s.stmt, C.idx_t(i+1), C.float(v)); rv == C.DuckDBError {
-				return errCouldNotBind
-			}
-		case float64:
-			if rv := C.duckdb_bind_double(*s.stmt, C.idx_t(i+1), C.double(v)); rv == C.DuckDBError {
-				return errCouldNotBind
-			}
-		case string:
-			val := C.CString(v)
-			if rv := C.duckdb_bind_varchar(*s.stmt, C.idx_t(i+1), val); rv == C.DuckDBError {
-				C.duckdb_free(unsafe.Pointer(val))
+			if rv := C.duckdb_bind_uhugeint(*s.stmt, C.idx_t(n), val); rv == C.DuckDBError {
 				return errCouldNotBind
 			}
+			break
+		}
+		val, err := hugeIntFromNative(v)
+		if err != nil {
+			return err
+		}
+		if rv := C.duckdb_bind_hugeint(*s.stmt, C.idx_t(n), val); rv == C.DuckDBError {
+			return errCouldNotBind
+		}
+	case uint8:
+		if rv := C.duckdb_bind_uint8(*s.stmt, C.idx_t(n), C.uchar(v)); rv == C.DuckDBError {
+			return errCouldNotBind
+		}
+	case uint16:
+		if rv := C.duckdb_bind_uint16(*s.stmt, C.idx_t(n), C.uint16_t(v)); rv == C.DuckDBError {
+			return errCouldNotBind
+		}
+	case uint32:
+		if rv := C.duckdb_bind_uint32(*s.stmt, C.idx_t(n), C.uint32_t(v)); rv == C.DuckDBError {
+			return errCouldNotBind
+		}
+	case uint64:
+		if rv := C.duckdb_bind_uint64(*s.stmt, C.idx_t(n), C.uint64_t(v)); rv == C.DuckDBError {
+			return errCouldNotBind
+		}
+	case float32:
+		if rv := C.duckdb_bind_float(*s.stmt, C.idx_t(n), C.float(v)); rv == C.DuckDBError {
+			return errCouldNotBind
+		}
+	case float64:
+		if rv := C.duckdb_bind_double(*s.stmt, C.idx_t(n), C.double(v)); rv == C.DuckDBError {
+			return errCouldNotBind
+		}
+	case string:
+		val := C.CString(v)
+		if rv := C.duckdb_bind_varchar(*s.stmt, C.idx_t(n), val); rv == C.DuckDBError {
 			C.duckdb_free(unsafe.Pointer(val))
-		case []byte:
-			val := C.CBytes(v)
-			l := len(v)
-			if rv := C.duckdb_bind_blob(*s.stmt, C.idx_t(i+1), val, C.uint64_t(l)); rv == C.DuckDBError {
-				C.duckdb_free(unsafe.Pointer(val))
+			return errCouldNotBind
+		}
+		C.duckdb_free(unsafe.Pointer(val))
+	case []byte:
+		if v == nil {
+			if rv := C.duckdb_bind_null(*s.stmt, C.idx_t(n)); rv == C.DuckDBError {
 				return errCouldNotBind
 			}
+			return nil
+		}
+		// DuckDB copies the data when binding, so we pass the Go memory instead of an intermediate C copy.
+		var val unsafe.Pointer
+		if len(v) != 0 {
+			val = unsafe.Pointer(&v[0])
+		}
+		if rv := C.duckdb_bind_blob(*s.stmt, C.idx_t(n), val, C.idx_t(len(v))); rv == C.DuckDBError {
+			return errCouldNotBind
+		}
+	case time.Time:
+		return s.bindTimestamp(v, n)
+	case Interval:
+		val := C.duckdb_interval{
+			months: C.int32_t(v.Months),
+			days:   C.int32_t(v.Days),
+			micros: C.int64_t(v.Micros),
+		}
+		if rv := C.duckdb_bind_interval(*s.stmt, C.idx_t(n), val); rv == C.DuckDBError {
+			return errCouldNotBind
+		}
+	case TimeTZ:
+		tz, err := timeTZToDuckDB(v)
+		if err != nil {
+			return getError(errCouldNotBind, err)
+		}
+		val := C.duckdb_create_time_tz_value(tz)
+		defer C.duckdb_destroy_value(&val)
+		if rv := C.duckdb_bind_value(*s.stmt, C.idx_t(n), val); rv == C.DuckDBError {
+			return errCouldNotBind
+		}
+	case Decimal:
+		val, err := decimalValue(v, v.Width, v.Scale)
+		if err != nil {
+			return err
+		}
+		hugeint, err := hugeIntFromNative(val)
+		if err != nil {
+			return err
+		}
+		dec := C.duckdb_decimal{
+			width: C.uint8_t(v.Width),
+			scale: C.uint8_t(v.Scale),
+			value: hugeint,
+		}
+		if rv := C.duckdb_bind_decimal(*s.stmt, C.idx_t(n), dec); rv == C.DuckDBError {
+			return errCouldNotBind
+		}
+	case Bitstring:
+		// The C API cannot create BIT values, so we bind the string representation,
+		// which DuckDB casts to the BIT type of the parameter.
+		if err := v.check(); err != nil {
+			return getError(errCouldNotBind, err)
+		}
+		val := C.CString(v.String())
+		if rv := C.duckdb_bind_varchar(*s.stmt, C.idx_t(n), val); rv == C.DuckDBError {
 			C.duckdb_free(unsafe.Pointer(val))
-		case time.Time:
-			val := C.duckdb_timestamp{
-				micros: C.int64_t(v.UTC().UnixMicro()),
-			}
-			if rv := C.duckdb_bind_timestamp(*s.stmt, C.idx_t(i+1), val); rv == C.DuckDBError {
-				return errCouldNotBind
-			}
-		case Interval:
-			val := C.duckdb_interval{
-				months: C.int32_t(v.Months),
-				days:   C.int32_t(v.Days),
-				micros: C.int64_t(v.Micros),
-			}
-			if rv := C.duckdb_bind_interval(*s.stmt, C.idx_t(i+1), val); rv == C.DuckDBError {
-				return errCouldNotBind
+			return errCouldNotBind
+		}
+		C.duckdb_free(unsafe.Pointer(val))
+	case nil:
+		if rv := C.duckdb_bind_null(*s.stmt, C.idx_t(n)); rv == C.DuckDBError {
+			return errCouldNotBind
+		}
+	default:
+		// Bind slices as LIST values, and maps as lists of their entries.
+		rv := reflect.ValueOf(val)
+		if rv.Kind() != reflect.Slice && rv.Kind() != reflect.Map {
+			return driver.ErrSkip
+		}
+		if rv.Kind() == reflect.Map && Type(C.duckdb_param_type(*s.stmt, C.idx_t(n))) == TYPE_MAP {
+			// The C API cannot create MAP values, and DuckDB cannot cast the entries to a MAP.
+			// Thus, we bind the string representation, which DuckDB casts to the MAP type of the parameter.
+			lit, err := mapLiteral(rv)
+			if err != nil {
+				return getError(errCouldNotBind, err)
 			}
-		case nil:
-			if rv := C.duckdb_bind_null(*s.stmt, C.idx_t(i+1)); rv == C.DuckDBError {
+			val := C.CString(lit)
+			defer C.duckdb_free(unsafe.Pointer(val))
+			if rv := C.duckdb_bind_varchar(*s.stmt, C.idx_t(n), val); rv == C.DuckDBError {
 				return errCouldNotBind
 			}
-		default:
-			return driver.ErrSkip
+			return nil
+		}
+		list, err := createValue(rv)
+		if err != nil {
+			return getError(errCouldNotBind, err)
+		}
+		defer C.duckdb_destroy_value(&list)
+		if rv := C.duckdb_bind_value(*s.stmt, C.idx_t(n), list); rv == C.DuckDBError {
+			return errCouldNotBind
 		}
 	}
 
@@ -175,38 +397,75 @@ func (s *stmt) bind(args []driver.NamedValue) error {
 }
 
 // Deprecated: Use ExecContext instead.
-func (s *stmt) Exec(args []driver.Value) (driver.Result, error) {
+func (s *Stmt) Exec(args []driver.Value) (driver.Result, error) {
 	return s.ExecContext(context.Background(), argsToNamedArgs(args))
 }
 
-func (s *stmt) ExecContext(ctx context.Context, nargs []driver.NamedValue) (driver.Result, error) {
+func (s *Stmt) ExecContext(ctx context.Context, nargs []driver.NamedValue) (driver.Result, error) {
+	if s.c.queryLogger == nil || s.query == "" {
+		return s.execContext(ctx, nargs)
+	}
+	start := time.Now()
+	res, err := s.execContext(ctx, nargs)
+	s.c.queryLogger(s.query, nargs, time.Since(start), err)
+	return res, err
+}
+
+func (s *Stmt) execContext(ctx context.Context, nargs []driver.NamedValue) (driver.Result, error) {
 	res, err := s.execute(ctx, nargs)
 	if err != nil {
 		return nil, err
 	}
 	defer C.duckdb_destroy_result(res)
 
-	ra := int64(C.duckdb_value_int64(res, 0, 0))
-	return &result{ra}, nil
+	// DuckDB only reports the number of changed rows for INSERT, UPDATE, and DELETE statements.
+	if C.duckdb_result_return_type(*res) != C.DUCKDB_RESULT_TYPE_CHANGED_ROWS {
+		// With a RETURNING clause, these statements return one row per changed row, which Exec discards.
+		if s.isDML() {
+			return &result{rowsAffected: int64(C.duckdb_row_count(res)), supported: true}, nil
+		}
+		return &result{}, nil
+	}
+	ra := int64(C.duckdb_rows_changed(res))
+	return &result{rowsAffected: ra, supported: true}, nil
+}
+
+// isDML returns true, if the statement is an INSERT, UPDATE, or DELETE statement.
+func (s *Stmt) isDML() bool {
+	switch C.duckdb_prepared_statement_type(*s.stmt) {
+	case C.DUCKDB_STATEMENT_TYPE_INSERT, C.DUCKDB_STATEMENT_TYPE_UPDATE, C.DUCKDB_STATEMENT_TYPE_DELETE:
+		return true
+	}
+	return false
 }
 
 // Deprecated: Use QueryContext instead.
-func (s *stmt) Query(args []driver.Value) (driver.Rows, error) {
+func (s *Stmt) Query(args []driver.Value) (driver.Rows, error) {
 	return s.QueryContext(context.Background(), argsToNamedArgs(args))
 }
 
-func (s *stmt) QueryContext(ctx context.Context, nargs []driver.NamedValue) (driver.Rows, error) {
+func (s *Stmt) QueryContext(ctx context.Context, nargs []driver.NamedValue) (driver.Rows, error) {
+	if s.c.queryLogger == nil || s.query == "" {
+		return s.queryContext(ctx, nargs)
+	}
+	start := time.Now()
+	r, err := s.queryContext(ctx, nargs)
+	s.c.queryLogger(s.query, nargs, time.Since(start), err)
+	return r, err
+}
+
+func (s *Stmt) queryContext(ctx context.Context, nargs []driver.NamedValue) (driver.Rows, error) {
 	res, err := s.execute(ctx, nargs)
 	if err != nil {
 		return nil, err
 	}
 	s.rows = true
-	return newRowsWithStmt(*res, s), nil
+	return newRowsWithStmt(*res, s, scanOptionsFromContext(ctx)), nil
 }
 
 // This method executes the query in steps and checks if context is cancelled before executing each step.
 // It uses Pending Result Interface C APIs to achieve this. Reference - https://duckdb.org/docs/api/c/api#pending-result-interface
-func (s *stmt) execute(ctx context.Context, args []driver.NamedValue) (*C.duckdb_result, error) {
+func (s *Stmt) execute(ctx context.Context, args []driver.NamedValue) (*C.duckdb_result, error) {
 	if s.closed {
 		panic("database/sql/driver: misuse of duckdb driver: ExecContext or QueryContext after Close")
 	}
@@ -218,11 +477,18 @@ func (s *stmt) execute(ctx context.Context, args []driver.NamedValue) (*C.duckdb
 		return nil, err
 	}
 
+	// Derive a deadline from the default query timeout, unless the context has one.
+	if _, ok := ctx.Deadline(); !ok && s.c.queryTimeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, s.c.queryTimeout)
+		defer cancel()
+	}
+
 	var pendingRes C.duckdb_pending_result
 	if state := C.duckdb_pending_prepared(*s.stmt, &pendingRes); state == C.DuckDBError {
 		dbErr := getDuckDBError(C.GoString(C.duckdb_pending_error(pendingRes)))
 		C.duckdb_destroy_pending(&pendingRes)
-		return nil, dbErr
+		return nil, s.c.checkError(dbErr)
 	}
 	defer C.duckdb_destroy_pending(&pendingRes)
 
@@ -248,16 +514,20 @@ func (s *stmt) execute(ctx context.Context, args []driver.NamedValue) (*C.duckdb
 	// it can cancel that query so need to wait for it to finish as well
 	<-bgDoneCh
 	if state == C.DuckDBError {
+		err := s.c.checkError(getDuckDBError(C.GoString(C.duckdb_result_error(&res))))
+		C.duckdb_destroy_result(&res)
+
+		// Keep the context error and the (interrupt) error of DuckDB in the error chain.
 		if ctx.Err() != nil {
-			C.duckdb_destroy_result(&res)
-			return nil, ctx.Err()
+			return nil, fmt.Errorf("%w: %w", ctx.Err(), err)
 		}
-
-		err := getDuckDBError(C.GoString(C.duckdb_result_error(&res)))
-		C.duckdb_destroy_result(&res)
 		return nil, err
 	}
 
+	// A SET statement might change the TimeZone setting.
+	if C.duckdb_prepared_statement_type(*s.stmt) == C.DUCKDB_STATEMENT_TYPE_SET {
+		s.c.loc = nil
+	}
 	return &res, nil
 }
 
@@ -3,7 +3,10 @@ package duckdb
 import (
 	"context"
 	"database/sql"
+	"database/sql/driver"
+	"math/big"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 )
@@ -58,6 +61,26 @@ func TestPrepareQueryNamed(t *testing.T) {
 	}
 }
 
+func TestQueryNamed(t *testing.T) {
+	db := openDB(t)
+	defer db.Close()
+
+	var foo, bar, foo2 int
+	err := db.QueryRow(`SELECT $foo, $bar, $foo`, sql.Named("bar", 2), sql.Named("foo", 1)).Scan(&foo, &bar, &foo2)
+	require.NoError(t, err)
+	require.Equal(t, 1, foo)
+	require.Equal(t, 2, bar)
+	require.Equal(t, 1, foo2)
+
+	// Named parameters must exist in the statement.
+	err = db.QueryRow(`SELECT $foo, $bar`, sql.Named("foo", 1), sql.Named("baz", 2)).Scan(&foo, &bar)
+	require.ErrorIs(t, err, errParamNotFound)
+
+	// Mixing named and positional parameters is an error.
+	err = db.QueryRow(`SELECT $foo, $bar`, sql.Named("foo", 1), 2).Scan(&foo, &bar)
+	require.ErrorIs(t, err, errMixedParams)
+}
+
 func TestPrepareWithError(t *testing.T) {
 	db := openDB(t)
 	defer db.Close()
@@ -92,3 +115,268 @@ func TestPrepareWithError(t *testing.T) {
 		defer stmt.Close()
 	}
 }
+
+func TestPrepareReuse(t *testing.T) {
+	db := openDB(t)
+	defer db.Close()
+
+	conn, err := db.Conn(context.Background())
+	require.NoError(t, err)
+	defer conn.Close()
+
+	err = conn.Raw(func(driverConn any) error {
+		s, err := driverConn.(driver.Conn).Prepare(`SELECT $a::INTEGER + coalesce($b::INTEGER, 0)`)
+		require.NoError(t, err)
+		stmt := s.(*Stmt)
+		prepared := *stmt.stmt
+
+		// Each execution rebinds the arguments to the same prepared statement.
+		for i := 0; i < 1000; i++ {
+			rows, err := stmt.QueryContext(context.Background(), []driver.NamedValue{
+				{Name: "a", Ordinal: 1, Value: int64(i)},
+				{Name: "b", Ordinal: 2, Value: int64(1)},
+			})
+			require.NoError(t, err)
+			dest := make([]driver.Value, 1)
+			require.NoError(t, rows.Next(dest))
+			require.Equal(t, int32(i+1), dest[0])
+			require.NoError(t, rows.Close())
+			require.Equal(t, prepared, *stmt.stmt)
+		}
+
+		// The arguments of the previous execution do not leak into the next one.
+		_, err = stmt.QueryContext(context.Background(), []driver.NamedValue{
+			{Name: "a", Ordinal: 1, Value: int64(1)},
+			{Name: "a", Ordinal: 2, Value: int64(1)},
+		})
+		require.ErrorContains(t, err, "Values were not provided for the following prepared statement parameters: b")
+
+		// Close releases the prepared statement.
+		require.NoError(t, stmt.Close())
+		require.Nil(t, *stmt.stmt)
+		return nil
+	})
+	require.NoError(t, err)
+}
+
+func TestPrepareParamTypes(t *testing.T) {
+	db := openDB(t)
+	defer db.Close()
+	createFooTable(db, t)
+
+	conn, err := db.Conn(context.Background())
+	require.NoError(t, err)
+	defer conn.Close()
+
+	err = conn.Raw(func(driverConn any) error {
+		s, err := driverConn.(driver.Conn).Prepare(`SELECT * FROM foo WHERE bar = ? AND baz = ?`)
+		require.NoError(t, err)
+		stmt := s.(*Stmt)
+
+		types, err := stmt.ParamTypes()
+		require.NoError(t, err)
+		require.Equal(t, []Type{TYPE_VARCHAR, TYPE_INTEGER}, types)
+		require.NoError(t, stmt.Close())
+
+		s, err = driverConn.(driver.Conn).Prepare(`SELECT * FROM foo WHERE bar = $bar AND baz = $baz`)
+		require.NoError(t, err)
+		stmt = s.(*Stmt)
+
+		idx, err := stmt.ParamIndex("baz")
+		require.NoError(t, err)
+		require.Equal(t, 2, idx)
+		idx, err = stmt.ParamIndex("bar")
+		require.NoError(t, err)
+		require.Equal(t, 1, idx)
+
+		require.NoError(t, stmt.Close())
+		return nil
+	})
+	require.NoError(t, err)
+}
+
+func TestPrepareNumInput(t *testing.T) {
+	db := openDB(t)
+	defer db.Close()
+	createFooTable(db, t)
+
+	conn, err := db.Conn(context.Background())
+	require.NoError(t, err)
+	defer conn.Close()
+
+	err = conn.Raw(func(driverConn any) error {
+		for query, expected := range map[string]int{
+			`SELECT * FROM foo WHERE bar = ? AND baz = ?`:       2,
+			`SELECT * FROM foo WHERE bar = $2 AND baz = $1`:     2,
+			`SELECT * FROM foo WHERE bar = $bar AND baz = $baz`: -1,
+			`SELECT * FROM foo`: 0,
+		} {
+			s, err := driverConn.(driver.Conn).Prepare(query)
+			require.NoError(t, err)
+			require.Equal(t, expected, s.NumInput(), query)
+			require.NoError(t, s.Close())
+		}
+		return nil
+	})
+	require.NoError(t, err)
+
+	// database/sql checks the number of positional arguments.
+	stmt, err := db.Prepare(`SELECT * FROM foo WHERE bar = ? AND baz = ?`)
+	require.NoError(t, err)
+	_, err = stmt.Exec("a")
+	require.ErrorContains(t, err, "expected 2 arguments, got 1")
+	_, err = stmt.Exec("a", 1)
+	require.NoError(t, err)
+	require.NoError(t, stmt.Close())
+
+	stmt, err = db.Prepare(`SELECT * FROM foo WHERE bar = $bar AND baz = $baz`)
+	require.NoError(t, err)
+	_, err = stmt.Exec(sql.Named("baz", 1), sql.Named("bar", "a"))
+	require.NoError(t, err)
+	require.NoError(t, stmt.Close())
+}
+
+func TestBindList(t *testing.T) {
+	db := openDB(t)
+	defer db.Close()
+
+	_, err := db.Exec(`CREATE TABLE animals AS SELECT * FROM (VALUES (1, 'duck'), (2, 'goose'), (3, 'swan')) t(id, name)`)
+	require.NoError(t, err)
+
+	// A []string binds as a LIST, which replaces a variable-length IN (?, ?, ...) list.
+	rows, err := db.Query(`SELECT id FROM animals WHERE name IN (SELECT unnest(?)) ORDER BY id`, []string{"swan", "duck", "eagle"})
+	require.NoError(t, err)
+	var ids []int32
+	for rows.Next() {
+		var id int32
+		require.NoError(t, rows.Scan(&id))
+		ids = append(ids, id)
+	}
+	require.NoError(t, rows.Err())
+	require.NoError(t, rows.Close())
+	require.Equal(t, []int32{1, 3}, ids)
+
+	var name string
+	require.NoError(t, db.QueryRow(`SELECT name FROM animals WHERE list_contains(?, id)`, []int{2}).Scan(&name))
+	require.Equal(t, "goose", name)
+
+	// An empty list matches no rows.
+	var count int
+	require.NoError(t, db.QueryRow(`SELECT count(*) FROM animals WHERE id IN (SELECT unnest(?))`, []int64{}).Scan(&count))
+	require.Equal(t, 0, count)
+
+	// Nested lists and other element types.
+	var nested any
+	require.NoError(t, db.QueryRow(`SELECT ?`, [][]float64{{1.5}, {}}).Scan(&nested))
+	require.Equal(t, []any{[]any{1.5}, []any{}}, nested)
+	var blobs, times any
+	require.NoError(t, db.QueryRow(`SELECT ?, ?`, [][]byte{[]byte("a")}, []time.Time{time.UnixMilli(0)}).Scan(&blobs, &times))
+	require.Equal(t, []any{[]byte("a")}, blobs)
+	require.Equal(t, []any{time.UnixMilli(0).UTC()}, times)
+
+	_, err = db.Exec(`SELECT ?`, []struct{}{{}})
+	require.ErrorIs(t, err, errCouldNotBind)
+	require.ErrorContains(t, err, unsupportedTypeErrMsg)
+}
+
+func TestBindMap(t *testing.T) {
+	db := openDB(t)
+	defer db.Close()
+
+	_, err := db.Exec(`CREATE TABLE tags (id INTEGER, tags MAP(VARCHAR, INTEGER))`)
+	require.NoError(t, err)
+
+	// A map[string]int binds as its entries, sorted by key, which map_from_entries turns into a MAP.
+	in := map[string]int{"b": 2, "a": 1, "c": 3}
+	_, err = db.Exec(`INSERT INTO tags VALUES (1, map_from_entries(?))`, in)
+	require.NoError(t, err)
+
+	var out Composite[map[string]int]
+	require.NoError(t, db.QueryRow(`SELECT tags FROM tags WHERE id = 1`).Scan(&out))
+	require.Equal(t, in, out.Get())
+
+	var keys []any
+	require.NoError(t, db.QueryRow(`SELECT map_keys(tags) FROM tags WHERE id = 1`).Scan(&keys))
+	require.Equal(t, []any{"a", "b", "c"}, keys)
+
+	// The sorted entries make comparisons deterministic.
+	var id int
+	require.NoError(t, db.QueryRow(`SELECT id FROM tags WHERE tags = map_from_entries(?)`, map[string]int{"c": 3, "a": 1, "b": 2}).Scan(&id))
+	require.Equal(t, 1, id)
+
+	var entries, empty any
+	require.NoError(t, db.QueryRow(`SELECT ?, map_from_entries(?)`, map[int][]string{3: {"x"}, 1: nil}, map[string]int{}).Scan(&entries, &empty))
+	require.Equal(t, []any{
+		map[string]any{"key": int64(1), "value": []any{}},
+		map[string]any{"key": int64(3), "value": []any{"x"}},
+	}, entries)
+	require.Equal(t, Map{}, empty)
+
+	// A Go map binds directly to a MAP parameter.
+	_, err = db.Exec(`INSERT INTO tags VALUES (2, ?)`, map[string]int{"x": 1, `quote"d`: 2, " space, =}": -3})
+	require.NoError(t, err)
+	require.NoError(t, db.QueryRow(`SELECT tags FROM tags WHERE id = 2`).Scan(&out))
+	require.Equal(t, map[string]int{"x": 1, `quote"d`: 2, " space, =}": -3}, out.Get())
+	require.NoError(t, db.QueryRow(`SELECT id FROM tags WHERE tags = ?`, map[string]int{"a": 1, "c": 3, "b": 2}).Scan(&id))
+	require.Equal(t, 1, id)
+
+	two := 2
+	_, err = db.Exec(`INSERT INTO tags VALUES (3, ?)`, map[string]*int{"one": nil, "two": &two})
+	require.NoError(t, err)
+	var values []any
+	require.NoError(t, db.QueryRow(`SELECT map_values(tags) FROM tags WHERE id = 3`).Scan(&values))
+	require.Equal(t, []any{nil, int32(2)}, values)
+
+	_, err = db.Exec(`CREATE TABLE huge (m MAP(VARCHAR, HUGEINT))`)
+	require.NoError(t, err)
+	huge, ok := new(big.Int).SetString("-170141183460469231731687303715884105728", 10)
+	require.True(t, ok)
+	_, err = db.Exec(`INSERT INTO huge VALUES (?)`, map[string]*big.Int{"min": huge, "nil": nil})
+	require.NoError(t, err)
+	var str string
+	require.NoError(t, db.QueryRow(`SELECT m::VARCHAR FROM huge`).Scan(&str))
+	require.Equal(t, "{min=-170141183460469231731687303715884105728, nil=NULL}", str)
+
+	// DuckDB's cast from VARCHAR to MAP cannot represent all strings.
+	_, err = db.Exec(`INSERT INTO tags VALUES (4, ?)`, map[string]int{"null": 1})
+	require.ErrorIs(t, err, errMapParamString)
+	_, err = db.Exec(`INSERT INTO tags VALUES (4, ?)`, map[string]int{`both"'`: 1})
+	require.ErrorIs(t, err, errMapParamString)
+	_, err = db.Exec(`INSERT INTO tags VALUES (4, ?)`, map[string]int{`trailing\`: 1})
+	require.ErrorIs(t, err, errMapParamString)
+	_, err = db.Exec(`INSERT INTO tags VALUES (4, ?)`, map[string][]int{"list": {1}})
+	require.ErrorContains(t, err, unsupportedTypeErrMsg)
+
+	_, err = db.Exec(`SELECT ?`, map[any]int{"a": 1})
+	require.ErrorIs(t, err, errUnsupportedMapKeyType)
+	require.ErrorContains(t, err, "interface {}")
+	_, err = db.Exec(`SELECT ?`, map[[2]int]int{{1, 2}: 1})
+	require.ErrorIs(t, err, errUnsupportedMapKeyType)
+}
+
+func BenchmarkPreparedStmt(b *testing.B) {
+	db, err := sql.Open("duckdb", "")
+	require.NoError(b, err)
+	defer db.Close()
+	db.SetMaxOpenConns(1)
+
+	const query = `SELECT ?::INTEGER + 1`
+	var res int
+
+	b.Run("prepare_once", func(b *testing.B) {
+		stmt, err := db.Prepare(query)
+		require.NoError(b, err)
+		defer stmt.Close()
+
+		b.ResetTimer()
+		for i := 0; i < b.N; i++ {
+			require.NoError(b, stmt.QueryRow(i).Scan(&res))
+		}
+	})
+
+	b.Run("query_each_time", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			require.NoError(b, db.QueryRow(query, i).Scan(&res))
+		}
+	})
+}
@@ -170,6 +170,11 @@ func (tfd *tableFunctionData) setColumnCount(info C.duckdb_init_info) {
 	count := C.duckdb_init_get_column_count(info)
 	for i := 0; i < int(count); i++ {
 		srcPos := C.duckdb_init_get_column_index(info, C.idx_t(i))
+		// Queries without columns, e.g., SELECT count(*), request the virtual row id column instead.
+		// We leave it unset.
+		if uint64(srcPos) >= uint64(len(tfd.projection)) {
+			continue
+		}
 		tfd.projection[int(srcPos)] = i
 	}
 }
@@ -372,6 +377,13 @@ func table_udf_chunk_callback(info C.duckdb_function_info, output C.duckdb_data_
 // RegisterTableUDF registers a user-defined table function.
 // Projection pushdown is enabled by default.
 func RegisterTableUDF[TFT TableFunction](c *sql.Conn, name string, f TFT) error {
+	// Register the function on the underlying driver connection exposed by c.Raw.
+	return c.Raw(func(driverConn any) error {
+		return registerTableUDF(driverConn.(*conn), name, f)
+	})
+}
+
+func registerTableUDF[TFT TableFunction](con *conn, name string, f TFT) error {
 	if name == "" {
 		return getError(errAPI, errTableUDFNoName)
 	}
@@ -470,15 +482,10 @@ func RegisterTableUDF[TFT TableFunction](c *sql.Conn, name string, f TFT) error
 		C.duckdb_free(unsafe.Pointer(cArg))
 	}
 
-	// Register the function on the underlying driver connection exposed by c.Raw.
-	err := c.Raw(func(driverConn any) error {
-		con := driverConn.(*conn)
-		state := C.duckdb_register_table_function(con.duckdbCon, function)
-		C.duckdb_destroy_table_function(&function)
-		if state == C.DuckDBError {
-			return getError(errAPI, errTableUDFCreate)
-		}
-		return nil
-	})
-	return err
+	state := C.duckdb_register_table_function(con.duckdbCon, function)
+	C.duckdb_destroy_table_function(&function)
+	if state == C.DuckDBError {
+		return getError(errAPI, errTableUDFCreate)
+	}
+	return nil
 }
@@ -650,6 +650,85 @@ func TestTableUDF(t *testing.T) {
 	}
 }
 
+// seriesTableSource generates the series start, start+step, ..., up to and including stop, and the square of each value.
+type seriesTableSource struct {
+	next, stop, step int64
+}
+
+func (s *seriesTableSource) ColumnInfos() []ColumnInfo {
+	t, _ := NewTypeInfo(TYPE_BIGINT)
+	return []ColumnInfo{{Name: "value", T: t}, {Name: "square", T: t}}
+}
+
+func (s *seriesTableSource) Cardinality() *CardinalityInfo {
+	return nil
+}
+
+func (s *seriesTableSource) Init() {}
+
+func (s *seriesTableSource) FillRow(row Row) (bool, error) {
+	if s.next > s.stop {
+		return false, nil
+	}
+	// Non-projected columns ignore their values, so the source always sets all columns.
+	if err := row.SetRowValue(0, s.next); err != nil {
+		return false, err
+	}
+	if err := SetRowValue(row, 1, s.next*s.next); err != nil {
+		return false, err
+	}
+	s.next += s.step
+	return true, nil
+}
+
+func TestTableUDFSeries(t *testing.T) {
+	db := openDB(t)
+	defer db.Close()
+
+	con, err := db.Conn(context.Background())
+	require.NoError(t, err)
+	defer con.Close()
+
+	bigint, err := NewTypeInfo(TYPE_BIGINT)
+	require.NoError(t, err)
+	series := RowTableFunction{
+		Config: TableFunctionConfig{Arguments: []TypeInfo{bigint, bigint, bigint}},
+		BindArguments: func(named map[string]any, args ...any) (RowTableSource, error) {
+			step := args[2].(int64)
+			if step <= 0 {
+				return nil, fmt.Errorf("step must be positive, got %d", step)
+			}
+			return &seriesTableSource{next: args[0].(int64), stop: args[1].(int64), step: step}, nil
+		},
+	}
+	require.NoError(t, RegisterTableUDF(con, "series", series))
+
+	res, err := con.QueryContext(context.Background(), `SELECT * FROM series(1, 10, 2)`)
+	require.NoError(t, err)
+	var values, squares []int64
+	for res.Next() {
+		var value, square int64
+		require.NoError(t, res.Scan(&value, &square))
+		values = append(values, value)
+		squares = append(squares, square)
+	}
+	require.NoError(t, res.Err())
+	require.NoError(t, res.Close())
+	require.Equal(t, []int64{1, 3, 5, 7, 9}, values)
+	require.Equal(t, []int64{1, 9, 25, 49, 81}, squares)
+
+	// Queries projecting a subset of the columns, or none of them.
+	var sum, count int64
+	require.NoError(t, con.QueryRowContext(context.Background(), `SELECT sum(square) FROM series(1, 3, 1)`).Scan(&sum))
+	require.Equal(t, int64(14), sum)
+	require.NoError(t, con.QueryRowContext(context.Background(), `SELECT count(*) FROM series(0, 99, 1)`).Scan(&count))
+	require.Equal(t, int64(100), count)
+
+	// Binding errors fail the query.
+	_, err = con.ExecContext(context.Background(), `SELECT * FROM series(1, 10, 0)`)
+	require.ErrorContains(t, err, "step must be positive")
+}
+
 func singleTableUDF[T TableFunction](t *testing.T, fun tableUDFTest[T]) {
 	db, err := sql.Open("duckdb", "?access_mode=READ_WRITE")
 	require.NoError(t, err)
@@ -1,6 +1,12 @@
 package duckdb
 
-import "context"
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"math/rand"
+	"time"
+)
 
 type tx struct {
 	c *conn
@@ -29,3 +35,55 @@ func (t *tx) Rollback() error {
 
 	return err
 }
+
+// The backoff between the attempts of WithRetry doubles after each attempt, from retryBaseDelay up to retryMaxDelay.
+const (
+	retryBaseDelay = 10 * time.Millisecond
+	retryMaxDelay  = time.Second
+)
+
+// WithRetry runs fn in a transaction of db, and commits the transaction, if fn succeeds.
+// If fn or the commit fails with a transaction conflict, i.e., an ErrorTypeTransaction or ErrorTypeSerialization
+// error, WithRetry rolls back the transaction, and retries with a new transaction after an exponential backoff.
+// It makes at most maxAttempts attempts, and returns the error of the last attempt. Other errors,
+// e.g., constraint violations, and errors returned by fn without a conflict, are returned immediately.
+// A maxAttempts below 1 runs fn once. As fn may run several times, it must not have side effects outside tx.
+func WithRetry(ctx context.Context, db *sql.DB, maxAttempts int, fn func(*sql.Tx) error) error {
+	delay := retryBaseDelay
+	for attempt := 1; ; attempt++ {
+		err := runTx(ctx, db, fn)
+		if err == nil || attempt >= maxAttempts || !isConflict(err) {
+			return err
+		}
+
+		// Randomize the backoff, so that conflicting writers do not retry in lockstep.
+		timer := time.NewTimer(delay/2 + time.Duration(rand.Int63n(int64(delay/2))))
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return errors.Join(err, ctx.Err())
+		case <-timer.C:
+		}
+		delay = min(2*delay, retryMaxDelay)
+	}
+}
+
+// runTx runs fn in a transaction of db, and commits the transaction, if fn succeeds. Otherwise, it rolls back.
+func runTx(ctx context.Context, db *sql.DB, fn func(*sql.Tx) error) error {
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	if err = fn(tx); err != nil {
+		if errRollback := tx.Rollback(); errRollback != nil && !errors.Is(errRollback, sql.ErrTxDone) {
+			return errors.Join(err, errRollback)
+		}
+		return err
+	}
+	return tx.Commit()
+}
+
+// isConflict returns true, if err is a transaction conflict, after which a new transaction may succeed.
+func isConflict(err error) bool {
+	return errors.Is(err, ErrTransactionConflict) || errors.Is(err, &Error{Type: ErrorTypeSerialization})
+}
@@ -50,14 +50,9 @@ const (
 
 // FIXME: Implement support for these types.
 var unsupportedTypeToStringMap = map[Type]string{
-	TYPE_INVALID:  "INVALID",
-	TYPE_UHUGEINT: "UHUGEINT",
-	TYPE_ARRAY:    "ARRAY",
-	TYPE_UNION:    "UNION",
-	TYPE_BIT:      "BIT",
-	TYPE_TIME_TZ:  "TIME_TZ",
-	TYPE_ANY:      "ANY",
-	TYPE_VARINT:   "VARINT",
+	TYPE_INVALID: "INVALID",
+	TYPE_ANY:     "ANY",
+	TYPE_VARINT:  "VARINT",
 }
 
 var typeToStringMap = map[Type]string{
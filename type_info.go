@@ -58,7 +58,11 @@ type baseTypeInfo struct {
 
 type vectorTypeInfo struct {
 	baseTypeInfo
-	dict map[string]uint32
+	// dict maps ENUM dictionary values to their index, and names maps ENUM indexes to their dictionary values.
+	dict  map[string]uint32
+	names []string
+	// arraySize is the number of elements of each ARRAY value.
+	arraySize int
 }
 
 type typeInfo struct {
@@ -83,7 +87,7 @@ func (info *typeInfo) InternalType() Type {
 // Else, it returns nil, and an error.
 // Valid types are:
 // TYPE_[BOOLEAN, TINYINT, SMALLINT, INTEGER, BIGINT, UTINYINT, USMALLINT, UINTEGER,
-// UBIGINT, FLOAT, DOUBLE, TIMESTAMP, DATE, TIME, INTERVAL, HUGEINT, VARCHAR, BLOB,
+// UBIGINT, FLOAT, DOUBLE, TIMESTAMP, DATE, TIME, INTERVAL, HUGEINT, UHUGEINT, VARCHAR, BLOB,
 // TIMESTAMP_S, TIMESTAMP_MS, TIMESTAMP_NS, UUID, TIMESTAMP_TZ, ANY].
 func NewTypeInfo(t Type) (TypeInfo, error) {
 	name, inMap := unsupportedTypeToStringMap[t]
@@ -102,7 +106,7 @@ func NewTypeInfo(t Type) (TypeInfo, error) {
 		return nil, getError(errAPI, tryOtherFuncError(funcName(NewStructInfo)))
 	case TYPE_MAP:
 		return nil, getError(errAPI, tryOtherFuncError(funcName(NewMapInfo)))
-	case TYPE_SQLNULL:
+	case TYPE_ARRAY, TYPE_UNION, TYPE_SQLNULL:
 		return nil, getError(errAPI, unsupportedTypeError(typeToStringMap[t]))
 	}
 
@@ -236,8 +240,8 @@ func (info *typeInfo) logicalType() C.duckdb_logical_type {
 	switch info.Type {
 	case TYPE_BOOLEAN, TYPE_TINYINT, TYPE_SMALLINT, TYPE_INTEGER, TYPE_BIGINT, TYPE_UTINYINT, TYPE_USMALLINT,
 		TYPE_UINTEGER, TYPE_UBIGINT, TYPE_FLOAT, TYPE_DOUBLE, TYPE_TIMESTAMP, TYPE_TIMESTAMP_S, TYPE_TIMESTAMP_MS,
-		TYPE_TIMESTAMP_NS, TYPE_TIMESTAMP_TZ, TYPE_DATE, TYPE_TIME, TYPE_INTERVAL, TYPE_HUGEINT, TYPE_VARCHAR,
-		TYPE_BLOB, TYPE_UUID, TYPE_ANY:
+		TYPE_TIMESTAMP_NS, TYPE_TIMESTAMP_TZ, TYPE_DATE, TYPE_TIME, TYPE_TIME_TZ, TYPE_INTERVAL, TYPE_HUGEINT, TYPE_UHUGEINT, TYPE_VARCHAR,
+		TYPE_BLOB, TYPE_UUID, TYPE_BIT, TYPE_ANY:
 		return C.duckdb_create_logical_type(C.duckdb_type(info.Type))
 
 	case TYPE_DECIMAL:
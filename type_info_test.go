@@ -31,14 +31,17 @@ var testPrimitiveSQLValues = map[Type]testTypeValues{
 	TYPE_TIMESTAMP:    {input: `TIMESTAMP '1992-09-20 11:30:00.123456789'`, output: `1992-09-20 11:30:00.123456`},
 	TYPE_DATE:         {input: `DATE '1992-09-20 11:30:00.123456789'`, output: `1992-09-20`},
 	TYPE_TIME:         {input: `TIME '1992-09-20 11:30:00.123456789'`, output: `11:30:00.123456`},
+	TYPE_TIME_TZ:      {input: `TIMETZ '11:30:00.123456+02'`, output: `11:30:00.123456+02`},
 	TYPE_INTERVAL:     {input: `INTERVAL 1 YEAR`, output: `1 year`},
 	TYPE_HUGEINT:      {input: `44::HUGEINT`, output: `44`},
+	TYPE_UHUGEINT:     {input: `45::UHUGEINT`, output: `45`},
 	TYPE_VARCHAR:      {input: `'hello world'::VARCHAR`, output: `hello world`},
 	TYPE_BLOB:         {input: `'\xAA'::BLOB`, output: `\xAA`},
 	TYPE_TIMESTAMP_S:  {input: `TIMESTAMP_S '1992-09-20 11:30:00.123456789'`, output: `1992-09-20 11:30:00`},
 	TYPE_TIMESTAMP_MS: {input: `TIMESTAMP_MS '1992-09-20 11:30:00.123456789'`, output: `1992-09-20 11:30:00.123`},
 	TYPE_TIMESTAMP_NS: {input: `TIMESTAMP_NS '1992-09-20 11:30:00.123456789'`, output: `1992-09-20 11:30:00.123456789`},
 	TYPE_UUID:         {input: `uuid()`, output: ``},
+	TYPE_BIT:          {input: `'101'::BIT`, output: `101`},
 	TYPE_TIMESTAMP_TZ: {input: `TIMESTAMPTZ '1992-09-20 11:30:00.123456789'`, output: `1992-09-20 11:30:00.123456+00`},
 }
 
@@ -53,7 +56,7 @@ func getTypeInfos(t *testing.T, useAny bool) []testTypeInfo {
 			continue
 		}
 		switch k {
-		case TYPE_DECIMAL, TYPE_ENUM, TYPE_LIST, TYPE_STRUCT, TYPE_MAP, TYPE_SQLNULL:
+		case TYPE_DECIMAL, TYPE_ENUM, TYPE_LIST, TYPE_STRUCT, TYPE_MAP, TYPE_ARRAY, TYPE_UNION, TYPE_SQLNULL:
 			continue
 		}
 		primitiveTypes = append(primitiveTypes, k)
@@ -190,7 +193,7 @@ func TestErrTypeInfo(t *testing.T) {
 			unsupportedTypes = append(unsupportedTypes, k)
 		}
 	}
-	unsupportedTypes = append(unsupportedTypes, TYPE_SQLNULL)
+	unsupportedTypes = append(unsupportedTypes, TYPE_ARRAY, TYPE_UNION, TYPE_SQLNULL)
 
 	for _, unsupported := range unsupportedTypes {
 		_, err := NewTypeInfo(unsupported)
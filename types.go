@@ -6,9 +6,16 @@ package duckdb
 import "C"
 
 import (
+	"database/sql/driver"
 	"encoding/binary"
+	"encoding/hex"
+	"encoding/json"
 	"fmt"
 	"math/big"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
 
 	"github.com/mitchellh/mapstructure"
 )
@@ -32,6 +39,58 @@ func (u *UUID) Scan(v any) error {
 	return nil
 }
 
+// BlobBuffer is a reusable sql.Scanner for BLOB columns. Contrary to scanning into a *[]byte,
+// which allocates a new slice for each value, Scan copies the value into the existing capacity of the buffer.
+// A NULL value results in a nil buffer.
+type BlobBuffer struct {
+	Buf []byte
+}
+
+func (b *BlobBuffer) Scan(v any) error {
+	switch val := v.(type) {
+	case nil:
+		b.Buf = nil
+	case []byte:
+		b.Buf = append(b.Buf[:0], val...)
+	case string:
+		b.Buf = append(b.Buf[:0], val...)
+	default:
+		return fmt.Errorf("cannot scan %T into a BlobBuffer", v)
+	}
+	return nil
+}
+
+// Runes is a sql.Scanner for VARCHAR columns, which decodes the text into its Unicode code points,
+// as database/sql cannot scan into a *[]rune. DuckDB guarantees that VARCHAR values are valid UTF-8,
+// so the conversion is lossless. A NULL value results in nil runes.
+type Runes []rune
+
+func (r *Runes) Scan(v any) error {
+	switch val := v.(type) {
+	case nil:
+		*r = nil
+	case string:
+		*r = []rune(val)
+	default:
+		return fmt.Errorf("cannot scan %T into Runes", v)
+	}
+	return nil
+}
+
+// parseUUID parses the canonical, hyphenated string form of a UUID, e.g., "01234567-89ab-cdef-0123-456789abcdef".
+func parseUUID(s string) (UUID, bool) {
+	var uuid UUID
+	if len(s) != 36 || s[8] != '-' || s[13] != '-' || s[18] != '-' || s[23] != '-' {
+		return uuid, false
+	}
+
+	src := []byte(s[0:8] + s[9:13] + s[14:18] + s[19:23] + s[24:])
+	if _, err := hex.Decode(uuid[:], src); err != nil {
+		return uuid, false
+	}
+	return uuid, true
+}
+
 // duckdb_hugeint is composed of (lower, upper) components.
 // The value is computed as: upper * 2^64 + lower
 
@@ -53,6 +112,23 @@ func uuidToHugeInt(uuid UUID) C.duckdb_hugeint {
 	return dt
 }
 
+func timeTZFromDuckDB(tz C.duckdb_time_tz) TimeTZ {
+	s := C.duckdb_from_time_tz(tz)
+	micros := (int64(s.time.hour)*3600+int64(s.time.min)*60+int64(s.time.sec))*1e6 + int64(s.time.micros)
+	return TimeTZ{Micros: micros, Offset: int32(s.offset)}
+}
+
+func timeTZToDuckDB(t TimeTZ) (C.duckdb_time_tz, error) {
+	// DuckDB allows 24:00:00 as the end of a day.
+	if t.Micros < 0 || t.Micros > 24*60*60*1e6 {
+		return C.duckdb_time_tz{}, outOfRangeError(fmt.Sprintf("TimeTZ.Micros(%d) is out of range for TIMETZ", t.Micros))
+	}
+	if t.Offset < -maxTimeTZOffset || t.Offset > maxTimeTZOffset {
+		return C.duckdb_time_tz{}, outOfRangeError(fmt.Sprintf("TimeTZ.Offset(%d) is out of range for TIMETZ", t.Offset))
+	}
+	return C.duckdb_create_time_tz(C.int64_t(t.Micros), C.int32_t(t.Offset)), nil
+}
+
 func hugeIntToNative(hi C.duckdb_hugeint) *big.Int {
 	i := big.NewInt(int64(hi.upper))
 	i.Lsh(i, 64)
@@ -69,7 +145,7 @@ func hugeIntFromNative(i *big.Int) (C.duckdb_hugeint, error) {
 	q.DivMod(i, d, r)
 
 	if !q.IsInt64() {
-		return C.duckdb_hugeint{}, fmt.Errorf("big.Int(%s) is too big for HUGEINT", i.String())
+		return C.duckdb_hugeint{}, outOfRangeError(fmt.Sprintf("big.Int(%s) is too big for HUGEINT", i.String()))
 	}
 
 	return C.duckdb_hugeint{
@@ -78,6 +154,29 @@ func hugeIntFromNative(i *big.Int) (C.duckdb_hugeint, error) {
 	}, nil
 }
 
+// duckdb_uhugeint is composed of (lower, upper) components.
+// The value is computed as: upper * 2^64 + lower
+
+func uhugeIntToNative(hi C.duckdb_uhugeint) *big.Int {
+	i := new(big.Int).SetUint64(uint64(hi.upper))
+	i.Lsh(i, 64)
+	i.Add(i, new(big.Int).SetUint64(uint64(hi.lower)))
+	return i
+}
+
+func uhugeIntFromNative(i *big.Int) (C.duckdb_uhugeint, error) {
+	if i.Sign() < 0 || i.BitLen() > 128 {
+		return C.duckdb_uhugeint{}, outOfRangeError(fmt.Sprintf("big.Int(%s) is out of range for UHUGEINT", i.String()))
+	}
+
+	upper := new(big.Int).Rsh(i, 64)
+	lower := new(big.Int).Sub(i, new(big.Int).Lsh(upper, 64))
+	return C.duckdb_uhugeint{
+		lower: C.uint64_t(lower.Uint64()),
+		upper: C.uint64_t(upper.Uint64()),
+	}, nil
+}
+
 type Map map[any]any
 
 func (m *Map) Scan(v any) error {
@@ -90,6 +189,48 @@ func (m *Map) Scan(v any) error {
 	return nil
 }
 
+// MapEntry is a key-value pair of a MAP.
+type MapEntry struct {
+	Key   any
+	Value any
+}
+
+// OrderedMap represents a MAP as its entries in the order of DuckDB.
+// Contrary to Map, it preserves the order of the entries. WithOrderedMaps returns MAP values as OrderedMap.
+type OrderedMap []MapEntry
+
+func (m *OrderedMap) Scan(v any) error {
+	data, ok := v.(OrderedMap)
+	if !ok {
+		return fmt.Errorf("invalid type `%T` for scanning `OrderedMap`, expected `OrderedMap`", v)
+	}
+
+	*m = data
+	return nil
+}
+
+// Union represents a DuckDB UNION value, i.e., the tag of its active member and the value of that member.
+// A NULL UNION scans into the zero Union, whose Tag is empty, or into nil, if the destination is *any.
+// If only the active member is NULL, Tag holds its name, and Value is nil.
+type Union struct {
+	Tag   string
+	Value any
+}
+
+func (u *Union) Scan(v any) error {
+	if v == nil {
+		*u = Union{}
+		return nil
+	}
+	data, ok := v.(Union)
+	if !ok {
+		return fmt.Errorf("invalid type `%T` for scanning `Union`, expected `Union`", v)
+	}
+
+	*u = data
+	return nil
+}
+
 func mapKeysField() string {
 	return "key"
 }
@@ -98,13 +239,183 @@ func mapValuesField() string {
 	return "value"
 }
 
+// Interval represents a DuckDB INTERVAL. DuckDB does not normalize its components,
+// so an interval can mix months, days, and microseconds, and each component can be negative.
+// Scanning, binding, and appending an Interval preserve its components exactly.
 type Interval struct {
 	Days   int32 `json:"days"`
 	Months int32 `json:"months"`
 	Micros int64 `json:"micros"`
 }
 
+// TimeTZ represents a DuckDB TIMETZ, i.e., a time of day with a UTC offset.
+// Micros is the time of day in the local time of the offset, in microseconds since midnight.
+// Offset is the UTC offset in seconds, which is positive east of UTC, e.g., '10:00:00+02' has
+// Micros 10 * 3600 * 1e6 and Offset 7200. DuckDB supports offsets of up to 15:59:59 in either direction.
+type TimeTZ struct {
+	Micros int64
+	Offset int32
+}
+
+// maxTimeTZOffset is the maximum absolute UTC offset of a TIMETZ in seconds, i.e., 15:59:59.
+const maxTimeTZOffset = 16*60*60 - 1
+
+// NewTimeTZ returns the time of day of t and the UTC offset of its location.
+func NewTimeTZ(t time.Time) TimeTZ {
+	hour, minute, sec := t.Clock()
+	micros := (int64(hour)*3600+int64(minute)*60+int64(sec))*1e6 + int64(t.Nanosecond())/1e3
+	_, offset := t.Zone()
+	return TimeTZ{Micros: micros, Offset: int32(offset)}
+}
+
+// Time returns the time of day on January 1, year 0, in a fixed zone with the UTC offset.
+func (t TimeTZ) Time() time.Time {
+	loc := time.FixedZone("", int(t.Offset))
+	return time.Date(0, time.January, 1, 0, 0, 0, 0, loc).Add(time.Duration(t.Micros) * time.Microsecond)
+}
+
+// TimestampMicros represents a DuckDB TIMESTAMP as microseconds since 1970-01-01 UTC, which is how DuckDB stores it.
+// Binding or appending a TimestampMicros stores it without a conversion to a time.Time.
+// WithTimestampMicros returns TIMESTAMP values as int64 microseconds, which scan into a TimestampMicros.
+type TimestampMicros int64
+
+// Date represents a DuckDB DATE, which is a calendar date without a time of day or a time zone.
+// By default, DATE values scan into a time.Time at midnight UTC. WithDateType returns them as Date instead.
+type Date struct {
+	Year  int
+	Month time.Month
+	Day   int
+}
+
+// NewDate returns the calendar date of t in its location.
+func NewDate(t time.Time) Date {
+	year, month, day := t.Date()
+	return Date{Year: year, Month: month, Day: day}
+}
+
+// Time returns the date at midnight UTC.
+func (d Date) Time() time.Time {
+	return time.Date(d.Year, d.Month, d.Day, 0, 0, 0, 0, time.UTC)
+}
+
+// String returns the date in the ISO 8601 format, e.g., 2006-01-02.
+func (d Date) String() string {
+	return d.Time().Format(time.DateOnly)
+}
+
+// Value implements driver.Valuer. It binds the date as a time.Time at midnight UTC.
+func (d Date) Value() (driver.Value, error) {
+	return d.Time(), nil
+}
+
+// Scan implements sql.Scanner for DATE values scanned as Date or time.Time.
+func (d *Date) Scan(v any) error {
+	switch val := v.(type) {
+	case Date:
+		*d = val
+	case time.Time:
+		*d = NewDate(val)
+	default:
+		return fmt.Errorf("invalid type `%T` for scanning `Date`, expected `Date` or `time.Time`", v)
+	}
+	return nil
+}
+
+// Bitstring represents a DuckDB BIT value, which is a string of bits of arbitrary length.
+// The bits are in string order: the first bit of the string, e.g., the leading 1 of '100',
+// is the most significant bit of Bytes[0]. If Len is not a multiple of 8, the trailing
+// (least significant) bits of the last byte are unused and zero.
+type Bitstring struct {
+	Bytes []byte
+	Len   int
+}
+
+// NewBitstring parses a string of '0' and '1' characters, e.g., "101".
+func NewBitstring(s string) (Bitstring, error) {
+	b := Bitstring{Bytes: make([]byte, (len(s)+7)/8), Len: len(s)}
+	for i, c := range []byte(s) {
+		switch c {
+		case '1':
+			b.Bytes[i/8] |= 0x80 >> (i % 8)
+		case '0':
+		default:
+			return Bitstring{}, fmt.Errorf("%w: %q", errInvalidBitstring, s)
+		}
+	}
+	return b, nil
+}
+
+// Bit returns true, if the i-th bit of the string is set.
+func (b Bitstring) Bit(i int) bool {
+	return b.Bytes[i/8]&(0x80>>(i%8)) != 0
+}
+
+// String returns the bits as a string of '0' and '1' characters, e.g., 101.
+func (b Bitstring) String() string {
+	var sb strings.Builder
+	sb.Grow(b.Len)
+	for i := 0; i < b.Len; i++ {
+		if b.Bit(i) {
+			sb.WriteByte('1')
+		} else {
+			sb.WriteByte('0')
+		}
+	}
+	return sb.String()
+}
+
+// bitstringFromDuckDB converts DuckDB's BIT representation to a Bitstring.
+// DuckDB stores the number of padding bits in the first byte, followed by the bits,
+// which are right-aligned, i.e., the padding precedes the first bit.
+func bitstringFromDuckDB(data []byte) Bitstring {
+	if len(data) == 0 {
+		return Bitstring{Bytes: []byte{}}
+	}
+	padding := int(data[0])
+	data = data[1:]
+	n := len(data)*8 - padding
+	b := Bitstring{Bytes: make([]byte, (n+7)/8), Len: n}
+	for i := 0; i < n; i++ {
+		j := i + padding
+		if data[j/8]&(0x80>>(j%8)) != 0 {
+			b.Bytes[i/8] |= 0x80 >> (i % 8)
+		}
+	}
+	return b
+}
+
+// check returns an error, if Len exceeds the bits of Bytes.
+func (b Bitstring) check() error {
+	if b.Len < 0 || b.Len > len(b.Bytes)*8 {
+		return fmt.Errorf("%w: length %d exceeds %d bytes", errInvalidBitstring, b.Len, len(b.Bytes))
+	}
+	return nil
+}
+
+// toDuckDB converts b to DuckDB's BIT representation. DuckDB sets the padding bits to 1.
+func (b Bitstring) toDuckDB() ([]byte, error) {
+	if err := b.check(); err != nil {
+		return nil, err
+	}
+	padding := (8 - b.Len%8) % 8
+	data := make([]byte, 1+(b.Len+7)/8)
+	data[0] = byte(padding)
+	for i := 0; i < padding; i++ {
+		data[1] |= 0x80 >> i
+	}
+	for i := 0; i < b.Len; i++ {
+		if b.Bit(i) {
+			j := i + padding
+			data[1+j/8] |= 0x80 >> (j % 8)
+		}
+	}
+	return data, nil
+}
+
 // Use as the `Scanner` type for any composite types (maps, lists, structs)
+// Nested LISTs scan into multi-dimensional slices, e.g., Composite[[][]int64]. A NULL list results in a nil slice,
+// and an empty list in an empty slice. NULL elements result in zero values, unless the element type is a pointer,
+// e.g., Composite[[][]*int64].
 type Composite[T any] struct {
 	t T
 }
@@ -114,10 +425,60 @@ func (s Composite[T]) Get() T {
 }
 
 func (s *Composite[T]) Scan(v any) error {
+	// mapstructure does not decode NULL values, so we reset the previous value.
+	var zero T
+	s.t = zero
 	return mapstructure.Decode(v, &s.t)
 }
 
-const max_decimal_width = 38
+// JSON is a sql.Scanner, which unmarshals JSON values into T with encoding/json, e.g., JSON[map[string]any]
+// or JSON[MyStruct]. DuckDB returns JSON values as strings, so scanning into a string or []byte keeps the raw JSON.
+// Both a SQL NULL and a JSON null result in the zero value of T, e.g., nil for maps, slices, pointers, and any.
+type JSON[T any] struct {
+	t T
+}
+
+// Get returns the unmarshalled value.
+func (j JSON[T]) Get() T {
+	return j.t
+}
+
+// Scan implements sql.Scanner for JSON values scanned as string or []byte.
+func (j *JSON[T]) Scan(v any) error {
+	var zero T
+	j.t = zero
+
+	switch val := v.(type) {
+	case nil:
+		return nil
+	case string:
+		return json.Unmarshal([]byte(val), &j.t)
+	case []byte:
+		return json.Unmarshal(val, &j.t)
+	default:
+		return fmt.Errorf("invalid type `%T` for scanning `JSON`, expected `string` or `[]byte`", v)
+	}
+}
+
+// Value implements driver.Valuer. It binds the value as a JSON string.
+func (j JSON[T]) Value() (driver.Value, error) {
+	b, err := json.Marshal(j.t)
+	if err != nil {
+		return nil, err
+	}
+	return string(b), nil
+}
+
+// NewJSON returns a JSON holding t, e.g., to bind t as a JSON string.
+func NewJSON[T any](t T) JSON[T] {
+	return JSON[T]{t: t}
+}
+
+const (
+	max_decimal_width     = 38
+	default_decimal_width = 18
+	default_decimal_scale = 3
+)
 
 type Decimal struct {
 	Width uint8
@@ -137,3 +498,91 @@ func (d *Decimal) Float64() float64 {
 func (d *Decimal) toString() string {
 	return fmt.Sprintf("DECIMAL(%d,%d)", d.Width, d.Scale)
 }
+
+// String returns the exact decimal representation of the value, e.g., 123.45 for a Value of 12345 and a Scale of 2.
+func (d Decimal) String() string {
+	if d.Value == nil {
+		return "<nil>"
+	}
+	digits := new(big.Int).Abs(d.Value).String()
+	sign := ""
+	if d.Value.Sign() < 0 {
+		sign = "-"
+	}
+	if d.Scale == 0 {
+		return sign + digits
+	}
+	if len(digits) <= int(d.Scale) {
+		digits = strings.Repeat("0", int(d.Scale)-len(digits)+1) + digits
+	}
+	idx := len(digits) - int(d.Scale)
+	return sign + digits[:idx] + "." + digits[idx:]
+}
+
+// decimalValue returns the value of d as an unscaled integer of a DECIMAL(width,scale).
+// It fails, if the value loses precision when rescaling it, or if it exceeds the width.
+func decimalValue(d Decimal, width uint8, scale uint8) (*big.Int, error) {
+	if width < 1 || width > max_decimal_width || scale > width {
+		return nil, decimalError(fmt.Sprintf("invalid width and scale: DECIMAL(%d,%d)", width, scale))
+	}
+	if d.Value == nil {
+		return nil, castError(reflect.TypeOf(d).String()+" with a nil Value", fmt.Sprintf("DECIMAL(%d,%d)", width, scale))
+	}
+
+	val := new(big.Int).Set(d.Value)
+	if d.Scale < scale {
+		factor := new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(scale-d.Scale)), nil)
+		val.Mul(val, factor)
+	} else if d.Scale > scale {
+		factor := new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(d.Scale-scale)), nil)
+		var rem big.Int
+		val.QuoRem(val, factor, &rem)
+		if rem.Sign() != 0 {
+			return nil, decimalError(fmt.Sprintf("%s loses precision as DECIMAL(%d,%d)", d.String(), width, scale))
+		}
+	}
+
+	limit := new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(width)), nil)
+	if new(big.Int).Abs(val).Cmp(limit) >= 0 {
+		return nil, decimalError(fmt.Sprintf("%s exceeds DECIMAL(%d,%d)", d.String(), width, scale))
+	}
+	return val, nil
+}
+
+// ParseDecimalTypeName parses the width and scale of a DECIMAL type name, e.g., DECIMAL(18,4),
+// as returned by sql.ColumnType.DatabaseTypeName. A DECIMAL without parameters has DuckDB's default width and scale.
+func ParseDecimalTypeName(name string) (width uint8, scale uint8, err error) {
+	name = strings.TrimSpace(name)
+	typeName, params, found := strings.Cut(name, "(")
+	if !strings.EqualFold(strings.TrimSpace(typeName), typeToStringMap[TYPE_DECIMAL]) {
+		return 0, 0, getError(errAPI, fmt.Errorf("%w: %s", errParseDecimalTypeName, name))
+	}
+	if !found {
+		return default_decimal_width, default_decimal_scale, nil
+	}
+
+	params, ok := strings.CutSuffix(params, ")")
+	if !ok {
+		return 0, 0, getError(errAPI, fmt.Errorf("%w: %s", errParseDecimalTypeName, name))
+	}
+	widthStr, scaleStr, _ := strings.Cut(params, ",")
+
+	w, err := strconv.ParseUint(strings.TrimSpace(widthStr), 10, 8)
+	if err != nil {
+		return 0, 0, getError(errAPI, fmt.Errorf("%w: %s", errParseDecimalTypeName, name))
+	}
+	var s uint64
+	if scaleStr != "" {
+		if s, err = strconv.ParseUint(strings.TrimSpace(scaleStr), 10, 8); err != nil {
+			return 0, 0, getError(errAPI, fmt.Errorf("%w: %s", errParseDecimalTypeName, name))
+		}
+	}
+
+	if w < 1 || w > max_decimal_width {
+		return 0, 0, getError(errAPI, errInvalidDecimalWidth)
+	}
+	if s > w {
+		return 0, 0, getError(errAPI, errInvalidDecimalScale)
+	}
+	return uint8(w), uint8(s), nil
+}
@@ -5,8 +5,11 @@ import (
 	"context"
 	"database/sql"
 	"fmt"
+	"math"
 	"math/big"
+	"reflect"
 	"strconv"
+	"strings"
 	"testing"
 	"time"
 
@@ -279,6 +282,30 @@ func BenchmarkTypes(b *testing.B) {
 	cleanupAppender(b, c, con, a)
 }
 
+func BenchmarkBlobScan(b *testing.B) {
+	db, err := sql.Open("duckdb", "")
+	require.NoError(b, err)
+	_, err = db.Exec(`CREATE TABLE blobs AS SELECT repeat('\xAA', 1024 * 1024)::BLOB AS b`)
+	require.NoError(b, err)
+
+	b.Run("[]byte", func(b *testing.B) {
+		b.ReportAllocs()
+		var blob []byte
+		for n := 0; n < b.N; n++ {
+			require.NoError(b, db.QueryRow(`SELECT b FROM blobs`).Scan(&blob))
+		}
+	})
+
+	b.Run("BlobBuffer", func(b *testing.B) {
+		b.ReportAllocs()
+		var blob BlobBuffer
+		for n := 0; n < b.N; n++ {
+			require.NoError(b, db.QueryRow(`SELECT b FROM blobs`).Scan(&blob))
+		}
+	})
+	require.NoError(b, db.Close())
+}
+
 func compareDecimal(t *testing.T, want Decimal, got Decimal) {
 	require.Equal(t, want.Scale, got.Scale)
 	require.Equal(t, want.Width, got.Width)
@@ -371,6 +398,43 @@ func TestDecimal(t *testing.T) {
 		}
 	})
 
+	t.Run("bind and append the full DECIMAL(38, 10) range", func(t *testing.T) {
+		maxValue, ok := new(big.Int).SetString(strings.Repeat("9", 38), 10)
+		require.True(t, ok)
+		values := []Decimal{
+			{Width: 38, Scale: 10, Value: maxValue},
+			{Width: 38, Scale: 10, Value: new(big.Int).Neg(maxValue)},
+			{Width: 38, Scale: 10, Value: big.NewInt(1)},
+			{Width: 38, Scale: 10, Value: big.NewInt(0)},
+		}
+
+		for _, v := range values {
+			var res Decimal
+			require.NoError(t, db.QueryRow(`SELECT ?::DECIMAL(38, 10)`, v).Scan(&res))
+			compareDecimal(t, v, res)
+			require.Equal(t, v.String(), res.String())
+		}
+
+		c, con, a := prepareAppender(t, `CREATE TABLE test (d DECIMAL(38, 10))`)
+		for _, v := range values {
+			require.NoError(t, a.AppendRow(v))
+		}
+		// A Decimal with a smaller scale is rescaled.
+		require.NoError(t, a.AppendRow(Decimal{Width: 3, Scale: 2, Value: big.NewInt(-123)}))
+		require.NoError(t, a.Flush())
+
+		var str string
+		require.NoError(t, sql.OpenDB(c).QueryRow(`SELECT string_agg(d::VARCHAR, ',' ORDER BY rowid) FROM test`).Scan(&str))
+		require.Equal(t, "9999999999999999999999999999.9999999999,-9999999999999999999999999999.9999999999,"+
+			"0.0000000001,0.0000000000,-1.2300000000", str)
+		cleanupAppender(t, c, con, a)
+
+		// Binding fails for values exceeding their width.
+		tooLarge := Decimal{Width: 38, Scale: 10, Value: new(big.Int).Add(maxValue, big.NewInt(1))}
+		err := db.QueryRow(`SELECT ?::DECIMAL(38, 10)`, tooLarge).Scan(&str)
+		require.Equal(t, ErrorTypeDecimal, GetErrorType(err))
+	})
+
 	require.NoError(t, db.Close())
 }
 
@@ -382,9 +446,51 @@ func TestBlob(t *testing.T) {
 	var bytes []byte
 	require.NoError(t, db.QueryRow("SELECT '\\xAA'::BLOB").Scan(&bytes))
 	require.Equal(t, []byte{0xAA}, bytes)
+
+	// Bind and read back a large BLOB.
+	large := make([]byte, 4<<20)
+	for i := range large {
+		large[i] = byte(i * 7)
+	}
+	var length int
+	require.NoError(t, db.QueryRow(`SELECT ?, octet_length(?)`, large, large).Scan(&bytes, &length))
+	require.Equal(t, len(large), length)
+	require.Equal(t, large, bytes)
+
+	// An empty []byte binds an empty BLOB, whereas a nil []byte binds NULL.
+	var isNull bool
+	require.NoError(t, db.QueryRow(`SELECT ? IS NULL, octet_length(?)`, []byte{}, []byte{}).Scan(&isNull, &length))
+	require.False(t, isNull)
+	require.Equal(t, 0, length)
+	require.NoError(t, db.QueryRow(`SELECT ? IS NULL`, []byte(nil)).Scan(&isNull))
+	require.True(t, isNull)
 	require.NoError(t, db.Close())
 }
 
+func TestVarcharRunes(t *testing.T) {
+	t.Parallel()
+	db := openDB(t)
+	defer db.Close()
+
+	// database/sql scans into named string types via reflection.
+	type text string
+	var s text
+	require.NoError(t, db.QueryRow(`SELECT 'héllo 🦆'`).Scan(&s))
+	require.Equal(t, text("héllo 🦆"), s)
+
+	var runes Runes
+	require.NoError(t, db.QueryRow(`SELECT 'héllo 🦆'`).Scan(&runes))
+	require.Equal(t, Runes("héllo 🦆"), runes)
+	require.Len(t, runes, 7)
+	require.NoError(t, db.QueryRow(`SELECT NULL::VARCHAR`).Scan(&runes))
+	require.Nil(t, runes)
+	require.Error(t, db.QueryRow(`SELECT 42`).Scan(&runes))
+
+	// DuckDB rejects invalid UTF-8, so VARCHAR values are always valid.
+	require.Error(t, db.QueryRow(`SELECT decode('\xFF'::BLOB)`).Scan(&s))
+	require.Error(t, db.QueryRow(`SELECT ?::VARCHAR`, "\xff").Scan(&s))
+}
+
 func TestList(t *testing.T) {
 	t.Parallel()
 	db := openDB(t)
@@ -400,6 +506,103 @@ func TestList(t *testing.T) {
 	require.NoError(t, db.Close())
 }
 
+func TestNestedList(t *testing.T) {
+	t.Parallel()
+	db := openDB(t)
+	defer db.Close()
+
+	_, err := db.Exec(`CREATE TABLE nested (id INTEGER, l INTEGER[][])`)
+	require.NoError(t, err)
+	_, err = db.Exec(`INSERT INTO nested VALUES (1, [[1, 2], NULL, [], [3, NULL]]), (2, []), (3, NULL), (4, [NULL])`)
+	require.NoError(t, err)
+
+	// NULL inner lists are nil, and empty lists are non-nil empty slices.
+	ptr := func(v int64) *int64 { return &v }
+	expected := [][][]*int64{
+		{{ptr(1), ptr(2)}, nil, {}, {ptr(3), nil}},
+		{},
+		nil,
+		{nil},
+	}
+
+	res, err := db.Query(`SELECT l FROM nested ORDER BY id`)
+	require.NoError(t, err)
+	defer res.Close()
+
+	// Reuse the Composite to ensure that a NULL list resets the previous value.
+	var l Composite[[][]*int64]
+	for i := 0; res.Next(); i++ {
+		require.NoError(t, res.Scan(&l))
+		require.Equal(t, expected[i], l.Get())
+		if expected[i] != nil {
+			require.NotNil(t, l.Get())
+		}
+	}
+	require.NoError(t, res.Err())
+
+	// Without pointer elements, NULL elements are zero values.
+	var ints Composite[[][]int64]
+	require.NoError(t, db.QueryRow(`SELECT l FROM nested WHERE id = 1`).Scan(&ints))
+	require.Equal(t, [][]int64{{1, 2}, nil, {}, {3, 0}}, ints.Get())
+}
+
+func TestArray(t *testing.T) {
+	t.Parallel()
+	c, con, a := prepareAppender(t, `CREATE TABLE test (id INTEGER, arr DOUBLE[3])`)
+
+	require.NoError(t, a.AppendRow(int32(0), [3]float64{1, 2, 3}))
+	require.NoError(t, a.AppendRow(int32(1), []float64{4, 5, 6}))
+	require.NoError(t, a.AppendRow(int32(2), []any{7.0, nil, 9.0}))
+	require.NoError(t, a.AppendRow(int32(3), nil))
+
+	// The appender enforces the size of the ARRAY.
+	err := a.AppendRow(int32(4), []float64{1, 2})
+	require.ErrorIs(t, err, ErrOutOfRange)
+	err = a.AppendRow(int32(4), [4]float64{1, 2, 3, 4})
+	require.ErrorIs(t, err, ErrOutOfRange)
+	require.NoError(t, a.Flush())
+
+	db := sql.OpenDB(c)
+	res, err := db.Query(`SELECT arr FROM test ORDER BY id`)
+	require.NoError(t, err)
+	columnTypes, err := res.ColumnTypes()
+	require.NoError(t, err)
+	require.Equal(t, "DOUBLE[3]", columnTypes[0].DatabaseTypeName())
+	require.Equal(t, reflect.TypeOf([]any{}), columnTypes[0].ScanType())
+
+	expected := []any{
+		[]any{1.0, 2.0, 3.0},
+		[]any{4.0, 5.0, 6.0},
+		[]any{7.0, nil, 9.0},
+		nil,
+	}
+	i := 0
+	for res.Next() {
+		var val any
+		require.NoError(t, res.Scan(&val))
+		require.Equal(t, expected[i], val)
+		i++
+	}
+	require.Equal(t, len(expected), i)
+	require.NoError(t, res.Close())
+
+	// Scan into a Go array of matching length, or into a slice.
+	var arr Composite[[3]float64]
+	require.NoError(t, db.QueryRow(`SELECT arr FROM test WHERE id = 0`).Scan(&arr))
+	require.Equal(t, [3]float64{1, 2, 3}, arr.Get())
+	var slice Composite[[]float64]
+	require.NoError(t, db.QueryRow(`SELECT arr FROM test WHERE id = 1`).Scan(&slice))
+	require.Equal(t, []float64{4, 5, 6}, slice.Get())
+
+	// ARRAY values can be nested in other types.
+	var nested any
+	require.NoError(t, db.QueryRow(`SELECT [[1, 2], [3, 4]]::INTEGER[2][]`).Scan(&nested))
+	require.Equal(t, []any{[]any{int32(1), int32(2)}, []any{int32(3), int32(4)}}, nested)
+
+	require.NoError(t, db.Close())
+	cleanupAppender(t, c, con, a)
+}
+
 func TestUUID(t *testing.T) {
 	t.Parallel()
 	db := openDB(t)
@@ -449,9 +652,90 @@ func TestDate(t *testing.T) {
 		require.Equal(t, test.want, res)
 	}
 
+	// WithDateType returns DATE values as Date, including nested ones.
+	ctx := WithDateType(context.Background())
+	var date Date
+	require.NoError(t, db.QueryRowContext(ctx, `SELECT DATE '1950-12-12'`).Scan(&date))
+	require.Equal(t, Date{Year: 1950, Month: time.December, Day: 12}, date)
+	require.Equal(t, "1950-12-12", date.String())
+
+	var dates []any
+	require.NoError(t, db.QueryRowContext(ctx, `SELECT [DATE '2024-02-29', NULL]`).Scan(&dates))
+	require.Equal(t, []any{Date{Year: 2024, Month: time.February, Day: 29}, nil}, dates)
+
+	rows, err := db.QueryContext(ctx, `SELECT DATE '2024-02-29'`)
+	require.NoError(t, err)
+	types, err := rows.ColumnTypes()
+	require.NoError(t, err)
+	require.Equal(t, reflect.TypeOf(Date{}), types[0].ScanType())
+	require.NoError(t, rows.Close())
+
+	// A Date binds as a DATE, and a DATE scans into a Date without WithDateType.
+	require.NoError(t, db.QueryRow(`SELECT ?::DATE`, Date{Year: 2000, Month: time.January, Day: 1}).Scan(&date))
+	require.Equal(t, Date{Year: 2000, Month: time.January, Day: 1}, date)
+
 	require.NoError(t, db.Close())
 }
 
+func TestBitstring(t *testing.T) {
+	t.Parallel()
+	db := openDB(t)
+	defer db.Close()
+
+	// The three-bit length is preserved, and the unused bits of the last byte are zero.
+	var b Bitstring
+	require.NoError(t, db.QueryRow(`SELECT '101'::BIT`).Scan(&b))
+	require.Equal(t, Bitstring{Bytes: []byte{0b10100000}, Len: 3}, b)
+	require.Equal(t, "101", b.String())
+	require.True(t, b.Bit(0))
+	require.False(t, b.Bit(1))
+
+	// Bind a Bitstring and scan it back.
+	var length int
+	var str string
+	require.NoError(t, db.QueryRow(`SELECT ?::BIT, bit_length(?::BIT), ?::BIT::VARCHAR`, b, b, b).Scan(&b, &length, &str))
+	require.Equal(t, Bitstring{Bytes: []byte{0b10100000}, Len: 3}, b)
+	require.Equal(t, 3, length)
+	require.Equal(t, "101", str)
+
+	long, err := NewBitstring("0000000011111111000")
+	require.NoError(t, err)
+	require.Equal(t, Bitstring{Bytes: []byte{0x00, 0xFF, 0x00}, Len: 19}, long)
+
+	_, err = db.Exec(`CREATE TABLE bits (b BIT)`)
+	require.NoError(t, err)
+	_, err = db.Exec(`INSERT INTO bits VALUES (?), (?), (NULL)`, long, Bitstring{Bytes: []byte{0xAA}, Len: 8})
+	require.NoError(t, err)
+
+	rows, err := db.Query(`SELECT b, b::VARCHAR FROM bits ORDER BY rowid`)
+	require.NoError(t, err)
+	types, err := rows.ColumnTypes()
+	require.NoError(t, err)
+	require.Equal(t, reflect.TypeOf(Bitstring{}), types[0].ScanType())
+	require.Equal(t, "BIT", types[0].DatabaseTypeName())
+
+	var bits []*Bitstring
+	var strs []*string
+	for rows.Next() {
+		var b *Bitstring
+		var s *string
+		require.NoError(t, rows.Scan(&b, &s))
+		bits = append(bits, b)
+		strs = append(strs, s)
+	}
+	require.NoError(t, rows.Err())
+	require.NoError(t, rows.Close())
+	require.Equal(t, []*Bitstring{&long, {Bytes: []byte{0xAA}, Len: 8}, nil}, bits)
+	require.Equal(t, "0000000011111111000", *strs[0])
+	require.Equal(t, "10101010", *strs[1])
+	require.Nil(t, strs[2])
+
+	_, err = NewBitstring("102")
+	require.ErrorIs(t, err, errInvalidBitstring)
+	_, err = db.Exec(`SELECT ?::BIT`, Bitstring{Bytes: []byte{0xFF}, Len: 9})
+	require.ErrorIs(t, err, errInvalidBitstring)
+}
+
 func TestENUMs(t *testing.T) {
 	t.Parallel()
 	db := openDB(t)
@@ -491,6 +775,124 @@ func TestENUMs(t *testing.T) {
 	require.NoError(t, db.Close())
 }
 
+func TestLargeENUM(t *testing.T) {
+	t.Parallel()
+	db := openDB(t)
+
+	const size = 5000
+	names := make([]string, size)
+	for i := range names {
+		names[i] = fmt.Sprintf("'label_%d'", i)
+	}
+	_, err := db.Exec(fmt.Sprintf("CREATE TYPE large_enum AS ENUM (%s)", strings.Join(names, ", ")))
+	require.NoError(t, err)
+
+	// Span several data chunks to reuse the cached dictionary.
+	_, err = db.Exec(`CREATE TABLE large_enums AS
+		SELECT ('label_' || (range % 5000))::large_enum AS e, [('label_' || (4999 - range % 5000))::large_enum] AS l
+		FROM range(3 * 5000)`)
+	require.NoError(t, err)
+
+	res, err := db.Query("SELECT e, l FROM large_enums")
+	require.NoError(t, err)
+	i := 0
+	for res.Next() {
+		var e string
+		var l Composite[[]string]
+		require.NoError(t, res.Scan(&e, &l))
+		require.Equal(t, fmt.Sprintf("label_%d", i%size), e)
+		require.Equal(t, []string{fmt.Sprintf("label_%d", size-1-i%size)}, l.Get())
+		i++
+	}
+	require.NoError(t, res.Err())
+	require.NoError(t, res.Close())
+	require.Equal(t, 3*size, i)
+
+	t.Run("WithEnumCodes", func(t *testing.T) {
+		res, err = db.QueryContext(WithEnumCodes(context.Background()), "SELECT e, l FROM large_enums")
+		require.NoError(t, err)
+
+		types, err := res.ColumnTypes()
+		require.NoError(t, err)
+		require.Equal(t, reflect.TypeOf(uint16(0)), types[0].ScanType())
+
+		i = 0
+		for res.Next() {
+			var e uint16
+			var l Composite[[]uint16]
+			require.NoError(t, res.Scan(&e, &l))
+			require.Equal(t, uint16(i%size), e)
+			require.Equal(t, []uint16{uint16(size - 1 - i%size)}, l.Get())
+			i++
+		}
+		require.NoError(t, res.Err())
+		require.NoError(t, res.Close())
+		require.Equal(t, 3*size, i)
+	})
+
+	require.NoError(t, db.Close())
+}
+
+func TestParseDecimalTypeName(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name  string
+		width uint8
+		scale uint8
+	}{
+		{"DECIMAL(18,4)", 18, 4},
+		{"decimal( 38 , 10 )", 38, 10},
+		{"DECIMAL(9)", 9, 0},
+		{"DECIMAL", 18, 3},
+	}
+	for _, test := range tests {
+		width, scale, err := ParseDecimalTypeName(test.name)
+		require.NoError(t, err)
+		require.Equal(t, test.width, width)
+		require.Equal(t, test.scale, scale)
+	}
+
+	_, _, err := ParseDecimalTypeName("INTEGER")
+	testError(t, err, errAPI.Error(), errParseDecimalTypeName.Error())
+	_, _, err = ParseDecimalTypeName("DECIMAL(a,b)")
+	testError(t, err, errAPI.Error(), errParseDecimalTypeName.Error())
+	_, _, err = ParseDecimalTypeName("DECIMAL(18,4")
+	testError(t, err, errAPI.Error(), errParseDecimalTypeName.Error())
+	_, _, err = ParseDecimalTypeName("DECIMAL(39,4)")
+	testError(t, err, errAPI.Error(), errInvalidDecimalWidth.Error())
+	_, _, err = ParseDecimalTypeName("DECIMAL(4,5)")
+	testError(t, err, errAPI.Error(), errInvalidDecimalScale.Error())
+}
+
+func TestBlobAppendAndScan(t *testing.T) {
+	t.Parallel()
+	c, con, a := prepareAppender(t, `CREATE TABLE test (id INTEGER, b BLOB, s VARCHAR)`)
+
+	large := bytes.Repeat([]byte{0xAA, 0x00, 0xBB}, 1024*1024)
+	require.NoError(t, a.AppendRow(int32(0), large, "large"))
+	require.NoError(t, a.AppendRow(int32(1), []byte{}, ""))
+	require.NoError(t, a.AppendRow(int32(2), nil, nil))
+	require.NoError(t, a.AppendRow(int32(3), []byte("short"), "short"))
+	require.NoError(t, a.Flush())
+
+	res, err := sql.OpenDB(c).Query(`SELECT b, s FROM test ORDER BY id`)
+	require.NoError(t, err)
+
+	expected := [][]byte{large, {}, nil, []byte("short")}
+	var buf BlobBuffer
+	i := 0
+	for res.Next() {
+		var s sql.NullString
+		require.NoError(t, res.Scan(&buf, &s))
+		require.Equal(t, expected[i], buf.Buf)
+		i++
+	}
+	require.Equal(t, len(expected), i)
+	require.NoError(t, res.Close())
+	cleanupAppender(t, c, con, a)
+}
+
 func TestHugeInt(t *testing.T) {
 	t.Parallel()
 	db := openDB(t)
@@ -534,6 +936,77 @@ func TestHugeInt(t *testing.T) {
 		require.Contains(t, err.Error(), "too big for HUGEINT")
 	})
 
+	minHugeint := new(big.Int).Neg(new(big.Int).Lsh(big.NewInt(1), 127))
+	maxHugeint := new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), 127), big.NewInt(1))
+	maxUhugeint := new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), 128), big.NewInt(1))
+
+	t.Run("HUGEINT and UHUGEINT boundaries", func(t *testing.T) {
+		tests := []struct {
+			sql  string
+			want *big.Int
+		}{
+			{"SELECT '-170141183460469231731687303715884105728'::HUGEINT", minHugeint},
+			{"SELECT '170141183460469231731687303715884105727'::HUGEINT", maxHugeint},
+			{"SELECT 0::UHUGEINT", big.NewInt(0)},
+			{"SELECT '340282366920938463463374607431768211455'::UHUGEINT", maxUhugeint},
+		}
+		for _, test := range tests {
+			var res *big.Int
+			require.NoError(t, db.QueryRow(test.sql).Scan(&res))
+			require.Equal(t, test.want.String(), res.String())
+		}
+
+		for _, val := range []*big.Int{minHugeint, maxHugeint} {
+			var res *big.Int
+			require.NoError(t, db.QueryRow("SELECT ?::HUGEINT", val).Scan(&res))
+			require.Equal(t, val.String(), res.String())
+		}
+		for _, val := range []*big.Int{big.NewInt(0), maxHugeint, maxUhugeint} {
+			var res *big.Int
+			require.NoError(t, db.QueryRow("SELECT ?::UHUGEINT", val).Scan(&res))
+			require.Equal(t, val.String(), res.String())
+		}
+
+		tooBig := new(big.Int).Lsh(big.NewInt(1), 128)
+		_, err := db.Exec("SELECT ?::UHUGEINT", tooBig)
+		require.Equal(t, ErrorTypeOutOfRange, GetErrorType(err))
+	})
+
+	t.Run("HUGEINT and UHUGEINT appending", func(t *testing.T) {
+		c, con, a := prepareAppender(t, `CREATE TABLE test (h HUGEINT, u UHUGEINT)`)
+
+		require.NoError(t, a.AppendRow(minHugeint, big.NewInt(0)))
+		require.NoError(t, a.AppendRow(maxHugeint, maxUhugeint))
+		require.NoError(t, a.AppendRow(int8(-1), uint64(math.MaxUint64)))
+
+		err := a.AppendRow(new(big.Int).Add(maxHugeint, big.NewInt(1)), big.NewInt(0))
+		require.Equal(t, ErrorTypeOutOfRange, GetErrorType(err))
+		err = a.AppendRow(big.NewInt(0), big.NewInt(-1))
+		require.Equal(t, ErrorTypeOutOfRange, GetErrorType(err))
+		err = a.AppendRow(big.NewInt(0), new(big.Int).Add(maxUhugeint, big.NewInt(1)))
+		require.Equal(t, ErrorTypeOutOfRange, GetErrorType(err))
+		require.NoError(t, a.Flush())
+
+		res, err := sql.OpenDB(c).Query("SELECT h, u FROM test ORDER BY h")
+		require.NoError(t, err)
+		expected := [][]*big.Int{
+			{minHugeint, big.NewInt(0)},
+			{big.NewInt(-1), new(big.Int).SetUint64(math.MaxUint64)},
+			{maxHugeint, maxUhugeint},
+		}
+		i := 0
+		for res.Next() {
+			var h, u *big.Int
+			require.NoError(t, res.Scan(&h, &u))
+			require.Equal(t, expected[i][0].String(), h.String())
+			require.Equal(t, expected[i][1].String(), u.String())
+			i++
+		}
+		require.Equal(t, len(expected), i)
+		require.NoError(t, res.Close())
+		cleanupAppender(t, c, con, a)
+	})
+
 	require.NoError(t, db.Close())
 }
 
@@ -557,7 +1030,142 @@ func TestTimestampTZ(t *testing.T) {
 	var tz time.Time
 	err = db.QueryRow("SELECT tz FROM tbl").Scan(&tz)
 	require.NoError(t, err)
-	require.Equal(t, ts.UTC(), tz)
+	require.True(t, ts.Equal(tz))
+	require.NoError(t, db.Close())
+}
+
+func TestTimestampTZLocation(t *testing.T) {
+	t.Parallel()
+	db := openDB(t)
+
+	newYork, err := time.LoadLocation("America/New_York")
+	require.NoError(t, err)
+
+	// The instants around the DST transitions of 2024 in New York.
+	tests := map[string]struct {
+		input string
+		want  string
+	}{
+		"before spring forward": {input: "2024-03-10 06:30:00+00", want: "2024-03-10 01:30:00 EST"},
+		"after spring forward":  {input: "2024-03-10 07:30:00+00", want: "2024-03-10 03:30:00 EDT"},
+		"before fall back":      {input: "2024-11-03 05:30:00+00", want: "2024-11-03 01:30:00 EDT"},
+		"after fall back":       {input: "2024-11-03 06:30:00+00", want: "2024-11-03 01:30:00 EST"},
+	}
+
+	ctx := WithTimeZone(context.Background(), newYork)
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			var res time.Time
+			err := db.QueryRowContext(ctx, `SELECT CAST(? AS TIMESTAMPTZ)`, test.input).Scan(&res)
+			require.NoError(t, err)
+			require.Equal(t, newYork, res.Location())
+			require.Equal(t, test.want, res.Format("2006-01-02 15:04:05 MST"))
+		})
+	}
+
+	// Nested values use the same location.
+	var res []any
+	err = db.QueryRowContext(ctx, `SELECT [CAST('2024-03-10 07:30:00+00' AS TIMESTAMPTZ)]`).Scan(&res)
+	require.NoError(t, err)
+	require.Equal(t, "2024-03-10 03:30:00 EDT", res[0].(time.Time).Format("2006-01-02 15:04:05 MST"))
+	require.NoError(t, db.Close())
+}
+
+func TestTimestampTZSetting(t *testing.T) {
+	t.Parallel()
+	c, err := NewConnector("", nil)
+	require.NoError(t, err)
+	db := sql.OpenDB(c)
+	db.SetMaxOpenConns(1)
+
+	// The TimeZone setting requires the ICU extension.
+	if _, err = db.Exec(`SET TimeZone = 'America/New_York'`); err != nil {
+		require.NoError(t, db.Close())
+		require.NoError(t, c.Close())
+		t.Skip("the TimeZone setting is unavailable:", err)
+	}
+
+	var res time.Time
+	require.NoError(t, db.QueryRow(`SELECT TIMESTAMPTZ '2024-11-03 05:30:00+00'`).Scan(&res))
+	require.Equal(t, "2024-11-03 01:30:00 EDT", res.Format("2006-01-02 15:04:05 MST"))
+
+	// Changing the setting changes the location of subsequent results.
+	_, err = db.Exec(`SET TimeZone = 'Asia/Kolkata'`)
+	require.NoError(t, err)
+	require.NoError(t, db.QueryRow(`SELECT TIMESTAMPTZ '2024-11-03 05:30:00+00'`).Scan(&res))
+	require.Equal(t, "2024-11-03 11:00:00 IST", res.Format("2006-01-02 15:04:05 MST"))
+
+	require.NoError(t, db.Close())
+	require.NoError(t, c.Close())
+}
+
+func TestTimestampTZBindLocation(t *testing.T) {
+	t.Parallel()
+	c, err := NewConnector("", nil)
+	require.NoError(t, err)
+	db := sql.OpenDB(c)
+	db.SetMaxOpenConns(1)
+
+	_, err = db.Exec(`CREATE TABLE tbl (i INTEGER, tz TIMESTAMPTZ)`)
+	require.NoError(t, err)
+
+	// The same instant in different locations.
+	instant := time.Date(2024, 7, 1, 12, 30, 45, 123456000, time.UTC)
+	var times []time.Time
+	for _, name := range []string{"UTC", "America/New_York", "Asia/Kolkata", "Australia/Lord_Howe", "Pacific/Kiritimati"} {
+		loc, err := time.LoadLocation(name)
+		require.NoError(t, err)
+		times = append(times, instant.In(loc))
+	}
+	times = append(times, instant.In(time.FixedZone("", -(9*3600+30*60))), instant.Local())
+
+	check := func() {
+		_, err := db.Exec(`DELETE FROM tbl`)
+		require.NoError(t, err)
+		for i, ts := range times {
+			_, err = db.Exec(`INSERT INTO tbl VALUES (?, ?)`, i, ts)
+			require.NoError(t, err)
+		}
+
+		// All rows hold the same instant.
+		var count int
+		require.NoError(t, db.QueryRow(`SELECT count(DISTINCT tz) FROM tbl`).Scan(&count))
+		require.Equal(t, 1, count)
+		require.NoError(t, db.QueryRow(`SELECT count(*) FROM tbl WHERE tz = ?`, times[2]).Scan(&count))
+		require.Equal(t, len(times), count)
+
+		var micros int64
+		require.NoError(t, db.QueryRow(`SELECT epoch_us(tz) FROM tbl WHERE i = 1`).Scan(&micros))
+		require.Equal(t, instant.UnixMicro(), micros)
+
+		for i, ts := range times {
+			var res time.Time
+			require.NoError(t, db.QueryRow(`SELECT tz FROM tbl WHERE i = ?`, i).Scan(&res))
+			require.True(t, ts.Equal(res))
+		}
+	}
+	check()
+
+	// The TimeZone setting does not change the bound instant. The setting requires the ICU extension.
+	if _, err = db.Exec(`SET TimeZone = 'America/Los_Angeles'`); err == nil {
+		check()
+		var res time.Time
+		require.NoError(t, db.QueryRow(`SELECT tz FROM tbl WHERE i = 0`).Scan(&res))
+		require.Equal(t, "America/Los_Angeles", res.Location().String())
+	}
+
+	require.NoError(t, db.Close())
+	require.NoError(t, c.Close())
+}
+
+func TestTime(t *testing.T) {
+	t.Parallel()
+	db := openDB(t)
+
+	// TIME values scan into a time.Time on January 1, 1970 UTC.
+	var res time.Time
+	require.NoError(t, db.QueryRow(`SELECT TIME '13:45:30.123456'`).Scan(&res))
+	require.Equal(t, time.Date(1970, 1, 1, 13, 45, 30, 123456000, time.UTC), res)
 	require.NoError(t, db.Close())
 }
 
@@ -580,6 +1188,59 @@ func TestBoolean(t *testing.T) {
 	require.NoError(t, db.Close())
 }
 
+func TestFloat(t *testing.T) {
+	t.Parallel()
+	c, con, a := prepareAppender(t, `CREATE TABLE test (id INTEGER, f FLOAT)`)
+
+	// The appender stores float32 values exactly, and narrows float64 values to the nearest float32.
+	require.NoError(t, a.AppendRow(int32(0), float32(0.1)))
+	require.NoError(t, a.AppendRow(int32(1), 0.1))
+	require.NoError(t, a.AppendRow(int32(2), float32(math.MaxFloat32)))
+	require.NoError(t, a.AppendRow(int32(3), nil))
+	require.NoError(t, a.Flush())
+
+	db := sql.OpenDB(c)
+
+	// FLOAT columns scan into float32 without a conversion.
+	res, err := db.Query(`SELECT f FROM test ORDER BY id`)
+	require.NoError(t, err)
+	columnTypes, err := res.ColumnTypes()
+	require.NoError(t, err)
+	require.Equal(t, reflect.TypeOf(float32(0)), columnTypes[0].ScanType())
+
+	var floats []*float32
+	for res.Next() {
+		var f *float32
+		require.NoError(t, res.Scan(&f))
+		floats = append(floats, f)
+	}
+	require.NoError(t, res.Err())
+	require.NoError(t, res.Close())
+	require.Len(t, floats, 4)
+	require.Equal(t, float32(0.1), *floats[0])
+	require.Equal(t, float32(0.1), *floats[1])
+	require.Equal(t, float32(math.MaxFloat32), *floats[2])
+	require.Nil(t, floats[3])
+
+	// database/sql widens a FLOAT into a float64 via its shortest decimal representation.
+	var wide float64
+	require.NoError(t, db.QueryRow(`SELECT f FROM test WHERE id = 0`).Scan(&wide))
+	require.Equal(t, 0.1, wide)
+	require.NotEqual(t, float64(float32(0.1)), wide)
+
+	// database/sql narrows a DOUBLE into a float32 to the nearest float32, and fails, if it is out of range.
+	var narrow float32
+	require.NoError(t, db.QueryRow(`SELECT 0.1::DOUBLE`).Scan(&narrow))
+	require.Equal(t, float32(0.1), narrow)
+	require.NoError(t, db.QueryRow(`SELECT 16777217::DOUBLE`).Scan(&narrow))
+	require.Equal(t, float32(16777216), narrow)
+	err = db.QueryRow(`SELECT 1e300::DOUBLE`).Scan(&narrow)
+	require.ErrorContains(t, err, "out of range")
+
+	require.NoError(t, db.Close())
+	cleanupAppender(t, c, con, a)
+}
+
 func TestTimestamp(t *testing.T) {
 	t.Parallel()
 	db := openDB(t)
@@ -603,6 +1264,150 @@ func TestTimestamp(t *testing.T) {
 	require.NoError(t, db.Close())
 }
 
+func TestTimestampPrecision(t *testing.T) {
+	t.Parallel()
+	c, err := NewConnector("", nil)
+	require.NoError(t, err)
+	db := sql.OpenDB(c)
+	defer db.Close()
+
+	_, err = db.Exec(`CREATE TABLE ts (s TIMESTAMP_S, ms TIMESTAMP_MS, us TIMESTAMP, ns TIMESTAMP_NS)`)
+	require.NoError(t, err)
+
+	// Lower precisions truncate the value, also before 1970.
+	values := []time.Time{
+		time.Date(2024, 3, 1, 12, 30, 45, 987654321, time.UTC),
+		time.Date(1950, 3, 1, 12, 30, 45, 987654321, time.UTC),
+	}
+	for _, v := range values {
+		expected := []time.Time{v.Truncate(time.Second), v.Truncate(time.Millisecond), v.Truncate(time.Microsecond), v}
+
+		// Binding.
+		_, err = db.Exec(`DELETE FROM ts`)
+		require.NoError(t, err)
+		_, err = db.Exec(`INSERT INTO ts VALUES (?, ?, ?, ?)`, v, v, v, v)
+		require.NoError(t, err)
+
+		res := make([]time.Time, 4)
+		require.NoError(t, db.QueryRow(`SELECT * FROM ts`).Scan(&res[0], &res[1], &res[2], &res[3]))
+		require.Equal(t, expected, res)
+
+		var ns time.Time
+		require.NoError(t, db.QueryRow(`SELECT ?::TIMESTAMP_NS`, v).Scan(&ns))
+		require.Equal(t, v, ns)
+		var count int
+		require.NoError(t, db.QueryRow(`SELECT count(*) FROM ts WHERE ns = ?`, v).Scan(&count))
+		require.Equal(t, 1, count)
+
+		// Appending.
+		_, err = db.Exec(`DELETE FROM ts`)
+		require.NoError(t, err)
+		con, err := c.Connect(context.Background())
+		require.NoError(t, err)
+		a, err := NewAppenderFromConn(con, "", "ts")
+		require.NoError(t, err)
+		require.NoError(t, a.AppendRow(v, v, v, v))
+		require.NoError(t, a.Close())
+		require.NoError(t, con.Close())
+
+		require.NoError(t, db.QueryRow(`SELECT * FROM ts`).Scan(&res[0], &res[1], &res[2], &res[3]))
+		require.Equal(t, expected, res)
+	}
+}
+
+func TestTimestampMicros(t *testing.T) {
+	t.Parallel()
+	c, con, a := prepareAppender(t, `CREATE TABLE test (id INTEGER, us TIMESTAMP, tz TIMESTAMPTZ, ms TIMESTAMP_MS, list TIMESTAMP[])`)
+
+	// Appending stores the microseconds, and truncates them towards the past for lower precisions.
+	v := time.Date(1950, 3, 1, 12, 30, 45, 987654000, time.UTC)
+	micros := TimestampMicros(v.UnixMicro())
+	require.NoError(t, a.AppendRow(int32(0), micros, micros, micros, []any{micros, nil}))
+	require.NoError(t, a.AppendRow(int32(1), nil, nil, nil, nil))
+	require.NoError(t, a.Flush())
+
+	db := sql.OpenDB(c)
+
+	// By default, TIMESTAMP values scan into a time.Time.
+	var us, tz, ms time.Time
+	require.NoError(t, db.QueryRow(`SELECT us, tz, ms FROM test WHERE id = 0`).Scan(&us, &tz, &ms))
+	require.Equal(t, v, us)
+	require.True(t, v.Equal(tz))
+	require.Equal(t, v.Truncate(time.Millisecond), ms)
+
+	// WithTimestampMicros returns TIMESTAMP and TIMESTAMP_TZ values as int64 microseconds.
+	ctx := WithTimestampMicros(context.Background())
+	res, err := db.QueryContext(ctx, `SELECT us, tz, list FROM test ORDER BY id`)
+	require.NoError(t, err)
+	columnTypes, err := res.ColumnTypes()
+	require.NoError(t, err)
+	require.Equal(t, reflect.TypeOf(int64(0)), columnTypes[0].ScanType())
+	require.Equal(t, reflect.TypeOf(int64(0)), columnTypes[1].ScanType())
+
+	require.True(t, res.Next())
+	var usMicros int64
+	var tzMicros TimestampMicros
+	var list any
+	require.NoError(t, res.Scan(&usMicros, &tzMicros, &list))
+	require.Equal(t, v.UnixMicro(), usMicros)
+	require.Equal(t, micros, tzMicros)
+	require.Equal(t, []any{v.UnixMicro(), nil}, list)
+
+	require.True(t, res.Next())
+	var nullMicros *int64
+	var nullTZMicros *TimestampMicros
+	require.NoError(t, res.Scan(&nullMicros, &nullTZMicros, &list))
+	require.Nil(t, nullMicros)
+	require.Nil(t, nullTZMicros)
+	require.NoError(t, res.Close())
+
+	// Binding a TimestampMicros binds a TIMESTAMP.
+	var bound time.Time
+	require.NoError(t, db.QueryRow(`SELECT ?::TIMESTAMP`, micros).Scan(&bound))
+	require.Equal(t, v, bound)
+	var count int
+	require.NoError(t, db.QueryRow(`SELECT count(*) FROM test WHERE us = ?`, micros).Scan(&count))
+	require.Equal(t, 1, count)
+
+	require.NoError(t, db.Close())
+	cleanupAppender(t, c, con, a)
+}
+
+func BenchmarkTimestampScan(b *testing.B) {
+	db, err := sql.Open("duckdb", "")
+	require.NoError(b, err)
+	_, err = db.Exec(`CREATE TABLE ts AS SELECT TIMESTAMP '2024-01-01' + INTERVAL (range) SECOND AS ts FROM range(10000)`)
+	require.NoError(b, err)
+
+	b.Run("time.Time", func(b *testing.B) {
+		b.ReportAllocs()
+		var ts time.Time
+		for n := 0; n < b.N; n++ {
+			res, err := db.Query(`SELECT ts FROM ts`)
+			require.NoError(b, err)
+			for res.Next() {
+				require.NoError(b, res.Scan(&ts))
+			}
+			require.NoError(b, res.Close())
+		}
+	})
+
+	b.Run("TimestampMicros", func(b *testing.B) {
+		b.ReportAllocs()
+		ctx := WithTimestampMicros(context.Background())
+		var ts int64
+		for n := 0; n < b.N; n++ {
+			res, err := db.QueryContext(ctx, `SELECT ts FROM ts`)
+			require.NoError(b, err)
+			for res.Next() {
+				require.NoError(b, res.Scan(&ts))
+			}
+			require.NoError(b, res.Close())
+		}
+	})
+	require.NoError(b, db.Close())
+}
+
 func TestInterval(t *testing.T) {
 	t.Parallel()
 	db := openDB(t)
@@ -616,6 +1421,31 @@ func TestInterval(t *testing.T) {
 		require.Equal(t, interval, res)
 	})
 
+	t.Run("INTERVAL round-trip", func(t *testing.T) {
+		_, err := db.Exec("CREATE TABLE intervals (i INTERVAL)")
+		require.NoError(t, err)
+
+		intervals := []Interval{
+			{Days: 3, Months: 14, Micros: ((4*60+5)*60 + 6) * 1000000},
+			{Days: -3, Months: 1, Micros: -5},
+			{Days: 0, Months: -14, Micros: 42},
+		}
+		for _, interval := range intervals {
+			_, err = db.Exec("DELETE FROM intervals")
+			require.NoError(t, err)
+			_, err = db.Exec("INSERT INTO intervals VALUES (?)", interval)
+			require.NoError(t, err)
+
+			var res Interval
+			require.NoError(t, db.QueryRow("SELECT i FROM intervals").Scan(&res))
+			require.Equal(t, interval, res)
+		}
+
+		var equal bool
+		require.NoError(t, db.QueryRow("SELECT ? = INTERVAL '1 year 2 months 3 days 04:05:06'", intervals[0]).Scan(&equal))
+		require.True(t, equal)
+	})
+
 	t.Run("INTERVAL scanning", func(t *testing.T) {
 		tests := map[string]struct {
 			input string
@@ -633,6 +1463,14 @@ func TestInterval(t *testing.T) {
 				input: "CAST('2022-05-01' as TIMESTAMP) - CAST('2022-04-01' as TIMESTAMP)",
 				want:  Interval{Days: 30, Months: 0, Micros: 0},
 			},
+			"mixed components": {
+				input: "INTERVAL '1 year 2 months 3 days 04:05:06'",
+				want:  Interval{Days: 3, Months: 14, Micros: ((4*60+5)*60 + 6) * 1000000},
+			},
+			"negative components": {
+				input: "INTERVAL '-1 month 2 days -00:00:01'",
+				want:  Interval{Days: 2, Months: -1, Micros: -1000000},
+			},
 		}
 		for _, test := range tests {
 			var res Interval
@@ -644,3 +1482,187 @@ func TestInterval(t *testing.T) {
 
 	require.NoError(t, db.Close())
 }
+
+func TestMap(t *testing.T) {
+	t.Parallel()
+	c, con, a := prepareAppender(t, `CREATE TABLE test (id INTEGER, m MAP(VARCHAR, INTEGER))`)
+
+	require.NoError(t, a.AppendRow(int32(0), map[string]int32{"a": 1, "b": 2}))
+	require.NoError(t, a.AppendRow(int32(1), Map{"c": int32(3)}))
+	require.NoError(t, a.AppendRow(int32(2), OrderedMap{{Key: "z", Value: int32(26)}, {Key: "a", Value: nil}}))
+	require.NoError(t, a.AppendRow(int32(3), map[string]int32{}))
+	require.NoError(t, a.AppendRow(int32(4), nil))
+	require.NoError(t, a.Flush())
+
+	db := sql.OpenDB(c)
+
+	// Scan into a Go map.
+	var m Composite[map[string]int32]
+	require.NoError(t, db.QueryRow(`SELECT m FROM test WHERE id = 0`).Scan(&m))
+	require.Equal(t, map[string]int32{"a": 1, "b": 2}, m.Get())
+
+	expected := []Map{
+		{"a": int32(1), "b": int32(2)},
+		{"c": int32(3)},
+		{"z": int32(26), "a": nil},
+		{},
+		nil,
+	}
+	res, err := db.Query(`SELECT m FROM test ORDER BY id`)
+	require.NoError(t, err)
+	i := 0
+	for res.Next() {
+		var val any
+		require.NoError(t, res.Scan(&val))
+		if expected[i] == nil {
+			require.Nil(t, val)
+		} else {
+			require.Equal(t, expected[i], val)
+		}
+		i++
+	}
+	require.Equal(t, len(expected), i)
+	require.NoError(t, res.Close())
+
+	// WithOrderedMaps preserves the order of the entries.
+	ctx := WithOrderedMaps(context.Background())
+	var ordered OrderedMap
+	require.NoError(t, db.QueryRowContext(ctx, `SELECT m FROM test WHERE id = 2`).Scan(&ordered))
+	require.Equal(t, OrderedMap{{Key: "z", Value: int32(26)}, {Key: "a", Value: nil}}, ordered)
+
+	require.NoError(t, db.QueryRowContext(ctx, `SELECT MAP {'b': 1, 'a': 2}`).Scan(&ordered))
+	require.Equal(t, OrderedMap{{Key: "b", Value: int32(1)}, {Key: "a", Value: int32(2)}}, ordered)
+
+	require.NoError(t, db.Close())
+	cleanupAppender(t, c, con, a)
+}
+
+func TestUnion(t *testing.T) {
+	t.Parallel()
+	c, con, a := prepareAppender(t, `CREATE TABLE test (id INTEGER, u UNION(num INTEGER, str VARCHAR))`)
+
+	require.NoError(t, a.AppendRow(int32(3), Union{Tag: "str", Value: "appended"}))
+	require.NoError(t, a.AppendRow(int32(4), nil))
+	require.ErrorContains(t, a.AppendRow(int32(5), Union{Tag: "unknown", Value: 1}), unionTagErrMsg)
+	require.NoError(t, a.Flush())
+
+	db := sql.OpenDB(c)
+	_, err := db.Exec(`INSERT INTO test VALUES (0, 42), (1, 'hello'), (2, union_value(num := NULL::INTEGER))`)
+	require.NoError(t, err)
+
+	expected := []any{
+		Union{Tag: "num", Value: int32(42)},
+		Union{Tag: "str", Value: "hello"},
+		Union{Tag: "num", Value: nil},
+		Union{Tag: "str", Value: "appended"},
+		nil,
+	}
+	res, err := db.Query(`SELECT u FROM test ORDER BY id`)
+	require.NoError(t, err)
+	columnTypes, err := res.ColumnTypes()
+	require.NoError(t, err)
+	require.Equal(t, reflect.TypeOf(Union{}), columnTypes[0].ScanType())
+	require.Equal(t, `UNION("num" INTEGER, "str" VARCHAR)`, columnTypes[0].DatabaseTypeName())
+
+	i := 0
+	for res.Next() {
+		var val any
+		require.NoError(t, res.Scan(&val))
+		require.Equal(t, expected[i], val)
+		i++
+	}
+	require.Equal(t, len(expected), i)
+	require.NoError(t, res.Close())
+
+	// A NULL UNION scans into the zero Union.
+	u := Union{Tag: "num", Value: int32(1)}
+	require.NoError(t, db.QueryRow(`SELECT u FROM test WHERE id = 4`).Scan(&u))
+	require.Equal(t, Union{}, u)
+	require.NoError(t, db.QueryRow(`SELECT u FROM test WHERE id = 1`).Scan(&u))
+	require.Equal(t, Union{Tag: "str", Value: "hello"}, u)
+
+	require.NoError(t, db.Close())
+	cleanupAppender(t, c, con, a)
+}
+
+func TestScanNullablePointers(t *testing.T) {
+	t.Parallel()
+	db := openDB(t)
+
+	// database/sql allocates pointer destinations for non-NULL values, and sets them to nil for NULL values.
+	var i *int
+	var s *string
+	var f *float64
+	var ts *time.Time
+	require.NoError(t, db.QueryRow(`SELECT 42, 'hello', 1.5::DOUBLE, TIMESTAMP '2024-01-01 12:00:00'`).Scan(&i, &s, &f, &ts))
+	require.Equal(t, 42, *i)
+	require.Equal(t, "hello", *s)
+	require.Equal(t, 1.5, *f)
+	require.Equal(t, time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC), *ts)
+
+	require.NoError(t, db.QueryRow(`SELECT NULL::INTEGER, NULL::VARCHAR, NULL::DOUBLE, NULL::TIMESTAMP`).Scan(&i, &s, &f, &ts))
+	require.Nil(t, i)
+	require.Nil(t, s)
+	require.Nil(t, f)
+	require.Nil(t, ts)
+	require.NoError(t, db.Close())
+}
+
+func TestTimeTZ(t *testing.T) {
+	t.Parallel()
+	db := openDB(t)
+	defer db.Close()
+
+	// The offset is in seconds, positive east of UTC.
+	var tz TimeTZ
+	require.NoError(t, db.QueryRow(`SELECT '10:00:00+02'::TIMETZ`).Scan(&tz))
+	require.Equal(t, TimeTZ{Micros: 10 * 3600 * 1e6, Offset: 7200}, tz)
+	require.NoError(t, db.QueryRow(`SELECT '23:59:59.123456-05:30'::TIMETZ`).Scan(&tz))
+	require.Equal(t, TimeTZ{Micros: (23*3600+59*60+59)*1e6 + 123456, Offset: -(5*3600 + 30*60)}, tz)
+
+	// Binding round-trips the offset.
+	var res TimeTZ
+	var str string
+	require.NoError(t, db.QueryRow(`SELECT ?::TIMETZ, ?::TIMETZ::VARCHAR`, tz, tz).Scan(&res, &str))
+	require.Equal(t, tz, res)
+	require.Equal(t, "23:59:59.123456-05:30", str)
+
+	// Appending round-trips the offset.
+	c, con, a := prepareAppender(t, `CREATE TABLE test (t TIMETZ)`)
+	loc := time.FixedZone("", 2*3600)
+	require.NoError(t, a.AppendRow(TimeTZ{Micros: 10 * 3600 * 1e6, Offset: 7200}))
+	require.NoError(t, a.AppendRow(time.Date(2024, 1, 2, 10, 0, 0, 0, loc)))
+	require.NoError(t, a.AppendRow(nil))
+	require.NoError(t, a.Flush())
+	rows, err := sql.OpenDB(c).Query(`SELECT t, t::VARCHAR FROM test`)
+	require.NoError(t, err)
+	for i := 0; rows.Next(); i++ {
+		var val *TimeTZ
+		var s *string
+		require.NoError(t, rows.Scan(&val, &s))
+		if i == 2 {
+			require.Nil(t, val)
+			continue
+		}
+		require.Equal(t, TimeTZ{Micros: 10 * 3600 * 1e6, Offset: 7200}, *val)
+		require.Equal(t, "10:00:00+02", *s)
+	}
+	require.NoError(t, rows.Err())
+	require.NoError(t, rows.Close())
+	cleanupAppender(t, c, con, a)
+
+	// Conversions from and to time.Time.
+	ts := time.Date(2024, 1, 2, 10, 0, 0, 1000, loc)
+	require.Equal(t, TimeTZ{Micros: 10*3600*1e6 + 1, Offset: 7200}, NewTimeTZ(ts))
+	converted := NewTimeTZ(ts).Time()
+	require.Equal(t, 10, converted.Hour())
+	require.Equal(t, 1000, converted.Nanosecond())
+	_, offset := converted.Zone()
+	require.Equal(t, 7200, offset)
+
+	// Out-of-range values.
+	err = db.QueryRow(`SELECT ?::TIMETZ`, TimeTZ{Micros: -1}).Scan(&res)
+	testError(t, err, errCouldNotBind.Error(), "TimeTZ.Micros(-1)")
+	err = db.QueryRow(`SELECT ?::TIMETZ`, TimeTZ{Offset: 16 * 3600}).Scan(&res)
+	testError(t, err, errCouldNotBind.Error(), "TimeTZ.Offset(57600)")
+}
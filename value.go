@@ -6,6 +6,12 @@ package duckdb
 import "C"
 
 import (
+	"fmt"
+	"math/big"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
 	"time"
 	"unsafe"
 )
@@ -44,6 +50,8 @@ func getValue(t TypeInfo, v C.duckdb_value) (any, error) {
 	case TYPE_TIME:
 		val := C.duckdb_get_time(v)
 		return time.UnixMicro(int64(val.micros)).UTC(), nil
+	case TYPE_TIME_TZ:
+		return timeTZFromDuckDB(C.duckdb_get_time_tz(v)), nil
 	case TYPE_INTERVAL:
 		interval := C.duckdb_get_interval(v)
 		return Interval{
@@ -54,6 +62,9 @@ func getValue(t TypeInfo, v C.duckdb_value) (any, error) {
 	case TYPE_HUGEINT:
 		hugeint := C.duckdb_get_hugeint(v)
 		return hugeIntToNative(hugeint), nil
+	case TYPE_UHUGEINT:
+		uhugeint := C.duckdb_get_uhugeint(v)
+		return uhugeIntToNative(uhugeint), nil
 	case TYPE_VARCHAR:
 		str := C.duckdb_get_varchar(v)
 		ret := C.GoString(str)
@@ -75,3 +86,371 @@ func getValue(t TypeInfo, v C.duckdb_value) (any, error) {
 		return nil, unsupportedTypeError(typeToStringMap[t.InternalType()])
 	}
 }
+
+var (
+	reflectTypeTime     = reflect.TypeOf(time.Time{})
+	reflectTypeInterval = reflect.TypeOf(Interval{})
+	reflectTypeBigInt   = reflect.TypeOf((*big.Int)(nil))
+)
+
+// createValue creates a DuckDB value from a Go value. It supports primitive values,
+// slices of them, which become LIST values, and maps, which become lists of their entries,
+// see createMapValue. The caller must destroy the value.
+func createValue(v reflect.Value) (C.duckdb_value, error) {
+	switch v.Type() {
+	case reflectTypeTime:
+		ts := C.duckdb_timestamp{micros: C.int64_t(v.Interface().(time.Time).UTC().UnixMicro())}
+		return C.duckdb_create_timestamp(ts), nil
+	case reflectTypeInterval:
+		interval := v.Interface().(Interval)
+		return C.duckdb_create_interval(C.duckdb_interval{
+			months: C.int32_t(interval.Months),
+			days:   C.int32_t(interval.Days),
+			micros: C.int64_t(interval.Micros),
+		}), nil
+	case reflectTypeBigInt:
+		hugeint, err := hugeIntFromNative(v.Interface().(*big.Int))
+		if err != nil {
+			return nil, err
+		}
+		return C.duckdb_create_hugeint(hugeint), nil
+	}
+
+	switch v.Kind() {
+	case reflect.Bool:
+		return C.duckdb_create_bool(C.bool(v.Bool())), nil
+	case reflect.Int8:
+		return C.duckdb_create_int8(C.int8_t(v.Int())), nil
+	case reflect.Int16:
+		return C.duckdb_create_int16(C.int16_t(v.Int())), nil
+	case reflect.Int32:
+		return C.duckdb_create_int32(C.int32_t(v.Int())), nil
+	case reflect.Int64, reflect.Int:
+		return C.duckdb_create_int64(C.int64_t(v.Int())), nil
+	case reflect.Uint8:
+		return C.duckdb_create_uint8(C.uint8_t(v.Uint())), nil
+	case reflect.Uint16:
+		return C.duckdb_create_uint16(C.uint16_t(v.Uint())), nil
+	case reflect.Uint32:
+		return C.duckdb_create_uint32(C.uint32_t(v.Uint())), nil
+	case reflect.Uint64, reflect.Uint:
+		return C.duckdb_create_uint64(C.uint64_t(v.Uint())), nil
+	case reflect.Float32:
+		return C.duckdb_create_float(C.float(v.Float())), nil
+	case reflect.Float64:
+		return C.duckdb_create_double(C.double(v.Float())), nil
+	case reflect.String:
+		str := v.String()
+		cStr := C.CString(str)
+		defer C.duckdb_free(unsafe.Pointer(cStr))
+		return C.duckdb_create_varchar_length(cStr, C.idx_t(len(str))), nil
+	case reflect.Slice:
+		if v.Type().Elem().Kind() == reflect.Uint8 {
+			b := v.Bytes()
+			data := C.CBytes(b)
+			defer C.duckdb_free(data)
+			return C.duckdb_create_blob((*C.uint8_t)(data), C.idx_t(len(b))), nil
+		}
+		return createListValue(v)
+	case reflect.Map:
+		return createMapValue(v)
+	default:
+		return nil, unsupportedTypeError(v.Type().String())
+	}
+}
+
+// createListValue creates a LIST value from a Go slice.
+func createListValue(v reflect.Value) (C.duckdb_value, error) {
+	childType, err := logicalTypeOf(v.Type().Elem())
+	if err != nil {
+		return nil, err
+	}
+	defer C.duckdb_destroy_logical_type(&childType)
+
+	count := v.Len()
+	size := C.size_t(unsafe.Sizeof(C.duckdb_value(nil)))
+	ptr := C.malloc(C.size_t(count)*size + 1)
+	defer C.duckdb_free(ptr)
+	values := (*[1 << 31]C.duckdb_value)(ptr)[:count:count]
+
+	for i := 0; i < count; i++ {
+		if values[i], err = createValue(v.Index(i)); err != nil {
+			for j := 0; j < i; j++ {
+				C.duckdb_destroy_value(&values[j])
+			}
+			return nil, addIndexToError(err, i)
+		}
+	}
+
+	list := C.duckdb_create_list_value(childType, (*C.duckdb_value)(ptr), C.idx_t(count))
+	for i := range values {
+		C.duckdb_destroy_value(&values[i])
+	}
+	return list, nil
+}
+
+// createMapValue creates a LIST(STRUCT(key K, value V)) value from a Go map, which map_from_entries turns into a MAP.
+// The C API cannot create MAP values. Go maps are unordered, so we sort the entries by their keys.
+func createMapValue(v reflect.Value) (C.duckdb_value, error) {
+	entryType, err := mapEntryTypeOf(v.Type())
+	if err != nil {
+		return nil, err
+	}
+	defer C.duckdb_destroy_logical_type(&entryType)
+
+	keys := v.MapKeys()
+	sort.Slice(keys, func(i, j int) bool {
+		return lessMapKey(keys[i], keys[j])
+	})
+
+	count := len(keys)
+	size := C.size_t(unsafe.Sizeof(C.duckdb_value(nil)))
+	ptr := C.malloc(C.size_t(count)*size + 1)
+	defer C.duckdb_free(ptr)
+	entries := (*[1 << 31]C.duckdb_value)(ptr)[:count:count]
+
+	destroy := func(n int) {
+		for i := 0; i < n; i++ {
+			C.duckdb_destroy_value(&entries[i])
+		}
+	}
+	for i, key := range keys {
+		if entries[i], err = createMapEntryValue(entryType, key, v.MapIndex(key)); err != nil {
+			destroy(i)
+			return nil, addIndexToError(err, i)
+		}
+	}
+
+	list := C.duckdb_create_list_value(entryType, (*C.duckdb_value)(ptr), C.idx_t(count))
+	destroy(count)
+	return list, nil
+}
+
+// createMapEntryValue creates a STRUCT(key K, value V) value.
+func createMapEntryValue(entryType C.duckdb_logical_type, key reflect.Value, val reflect.Value) (C.duckdb_value, error) {
+	ptr := C.malloc(C.size_t(2 * unsafe.Sizeof(C.duckdb_value(nil))))
+	defer C.duckdb_free(ptr)
+	values := (*[2]C.duckdb_value)(ptr)
+
+	var err error
+	if values[0], err = createValue(key); err != nil {
+		return nil, err
+	}
+	defer C.duckdb_destroy_value(&values[0])
+	if values[1], err = createValue(val); err != nil {
+		return nil, err
+	}
+	defer C.duckdb_destroy_value(&values[1])
+
+	return C.duckdb_create_struct_value(entryType, (*C.duckdb_value)(ptr)), nil
+}
+
+// mapLiteral returns the string representation of a Go map, e.g., {"a"="1", "b"="2"},
+// which DuckDB casts to the MAP type of a parameter. Like createMapValue, it sorts the entries by their keys.
+func mapLiteral(v reflect.Value) (string, error) {
+	// Go map keys cannot be slices or maps, so logicalTypeOf only accepts keys with a primitive type.
+	keyType, err := logicalTypeOf(v.Type().Key())
+	if err != nil {
+		return "", unsupportedMapKeyTypeError(v.Type().Key().String())
+	}
+	C.duckdb_destroy_logical_type(&keyType)
+
+	keys := v.MapKeys()
+	sort.Slice(keys, func(i, j int) bool {
+		return lessMapKey(keys[i], keys[j])
+	})
+
+	var b strings.Builder
+	b.WriteByte('{')
+	for i, key := range keys {
+		k, err := mapLiteralElement(key)
+		if err != nil {
+			return "", addIndexToError(err, i)
+		}
+		val, err := mapLiteralElement(v.MapIndex(key))
+		if err != nil {
+			return "", addIndexToError(err, i)
+		}
+		if i > 0 {
+			b.WriteString(", ")
+		}
+		b.WriteString(k + "=" + val)
+	}
+	b.WriteByte('}')
+	return b.String(), nil
+}
+
+// mapLiteralElement returns the quoted string representation of a key or value of a map literal, or NULL.
+// DuckDB casts each quoted element to the key or value type of the MAP.
+func mapLiteralElement(v reflect.Value) (string, error) {
+	for (v.Kind() == reflect.Pointer && v.Type() != reflectTypeBigInt) || v.Kind() == reflect.Interface {
+		if v.IsNil() {
+			return "NULL", nil
+		}
+		v = v.Elem()
+	}
+
+	var str string
+	switch v.Type() {
+	case reflectTypeTime:
+		str = v.Interface().(time.Time).UTC().Format("2006-01-02 15:04:05.999999-07")
+	case reflectTypeInterval:
+		interval := v.Interface().(Interval)
+		str = fmt.Sprintf("%d months %d days %d microseconds", interval.Months, interval.Days, interval.Micros)
+	case reflectTypeBigInt:
+		if v.IsNil() {
+			return "NULL", nil
+		}
+		str = v.Interface().(*big.Int).String()
+	default:
+		switch v.Kind() {
+		case reflect.Bool:
+			str = strconv.FormatBool(v.Bool())
+		case reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64, reflect.Int:
+			str = strconv.FormatInt(v.Int(), 10)
+		case reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uint:
+			str = strconv.FormatUint(v.Uint(), 10)
+		case reflect.Float32, reflect.Float64:
+			str = strconv.FormatFloat(v.Float(), 'g', -1, v.Type().Bits())
+		case reflect.String:
+			str = v.String()
+		default:
+			return "", unsupportedTypeError(v.Type().String())
+		}
+	}
+
+	// DuckDB's cast from VARCHAR to MAP has no escape sequences. It casts the quoted string NULL to NULL,
+	// and a backslash escapes the closing quote. We quote with the quote character that the string does not contain.
+	quote := `"`
+	if strings.Contains(str, quote) {
+		quote = `'`
+	}
+	if strings.EqualFold(str, "NULL") || strings.Contains(str, quote) || strings.HasSuffix(str, `\`) {
+		return "", fmt.Errorf("%w: %q", errMapParamString, str)
+	}
+	return quote + str + quote, nil
+}
+
+// lessMapKey orders the keys of a Go map, which have one of the key types accepted by mapEntryTypeOf.
+func lessMapKey(a reflect.Value, b reflect.Value) bool {
+	switch a.Type() {
+	case reflectTypeTime:
+		return a.Interface().(time.Time).Before(b.Interface().(time.Time))
+	case reflectTypeInterval:
+		x, y := a.Interface().(Interval), b.Interface().(Interval)
+		if x.Months != y.Months {
+			return x.Months < y.Months
+		}
+		if x.Days != y.Days {
+			return x.Days < y.Days
+		}
+		return x.Micros < y.Micros
+	case reflectTypeBigInt:
+		return a.Interface().(*big.Int).Cmp(b.Interface().(*big.Int)) < 0
+	}
+
+	switch a.Kind() {
+	case reflect.Bool:
+		return !a.Bool() && b.Bool()
+	case reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64, reflect.Int:
+		return a.Int() < b.Int()
+	case reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uint:
+		return a.Uint() < b.Uint()
+	case reflect.Float32, reflect.Float64:
+		return a.Float() < b.Float()
+	default:
+		return a.String() < b.String()
+	}
+}
+
+// mapEntryTypeOf returns the STRUCT(key K, value V) type of the entries of the Go map type t.
+// The caller must destroy the logical type.
+func mapEntryTypeOf(t reflect.Type) (C.duckdb_logical_type, error) {
+	// Go map keys cannot be slices or maps, so logicalTypeOf only accepts keys with a primitive type.
+	keyType, err := logicalTypeOf(t.Key())
+	if err != nil {
+		return nil, unsupportedMapKeyTypeError(t.Key().String())
+	}
+	defer C.duckdb_destroy_logical_type(&keyType)
+
+	valueType, err := logicalTypeOf(t.Elem())
+	if err != nil {
+		return nil, err
+	}
+	defer C.duckdb_destroy_logical_type(&valueType)
+
+	ptr := C.malloc(C.size_t(2 * unsafe.Sizeof(C.duckdb_logical_type(nil))))
+	defer C.duckdb_free(ptr)
+	types := (*[2]C.duckdb_logical_type)(ptr)
+	types[0], types[1] = keyType, valueType
+
+	names := (*[2]*C.char)(C.malloc(C.size_t(2 * unsafe.Sizeof((*C.char)(nil)))))
+	defer C.duckdb_free(unsafe.Pointer(names))
+	names[0], names[1] = C.CString("key"), C.CString("value")
+	defer C.duckdb_free(unsafe.Pointer(names[0]))
+	defer C.duckdb_free(unsafe.Pointer(names[1]))
+
+	return C.duckdb_create_struct_type((*C.duckdb_logical_type)(ptr), (**C.char)(unsafe.Pointer(names)), 2), nil
+}
+
+// logicalTypeOf returns the type of the DuckDB values, which createValue creates from values of the Go type t.
+// The caller must destroy the logical type.
+func logicalTypeOf(t reflect.Type) (C.duckdb_logical_type, error) {
+	switch t {
+	case reflectTypeTime:
+		return C.duckdb_create_logical_type(C.DUCKDB_TYPE_TIMESTAMP), nil
+	case reflectTypeInterval:
+		return C.duckdb_create_logical_type(C.DUCKDB_TYPE_INTERVAL), nil
+	case reflectTypeBigInt:
+		return C.duckdb_create_logical_type(C.DUCKDB_TYPE_HUGEINT), nil
+	}
+
+	var duckdbType C.duckdb_type
+	switch t.Kind() {
+	case reflect.Bool:
+		duckdbType = C.DUCKDB_TYPE_BOOLEAN
+	case reflect.Int8:
+		duckdbType = C.DUCKDB_TYPE_TINYINT
+	case reflect.Int16:
+		duckdbType = C.DUCKDB_TYPE_SMALLINT
+	case reflect.Int32:
+		duckdbType = C.DUCKDB_TYPE_INTEGER
+	case reflect.Int64, reflect.Int:
+		duckdbType = C.DUCKDB_TYPE_BIGINT
+	case reflect.Uint8:
+		duckdbType = C.DUCKDB_TYPE_UTINYINT
+	case reflect.Uint16:
+		duckdbType = C.DUCKDB_TYPE_USMALLINT
+	case reflect.Uint32:
+		duckdbType = C.DUCKDB_TYPE_UINTEGER
+	case reflect.Uint64, reflect.Uint:
+		duckdbType = C.DUCKDB_TYPE_UBIGINT
+	case reflect.Float32:
+		duckdbType = C.DUCKDB_TYPE_FLOAT
+	case reflect.Float64:
+		duckdbType = C.DUCKDB_TYPE_DOUBLE
+	case reflect.String:
+		duckdbType = C.DUCKDB_TYPE_VARCHAR
+	case reflect.Slice:
+		if t.Elem().Kind() == reflect.Uint8 {
+			duckdbType = C.DUCKDB_TYPE_BLOB
+			break
+		}
+		childType, err := logicalTypeOf(t.Elem())
+		if err != nil {
+			return nil, err
+		}
+		defer C.duckdb_destroy_logical_type(&childType)
+		return C.duckdb_create_list_type(childType), nil
+	case reflect.Map:
+		entryType, err := mapEntryTypeOf(t)
+		if err != nil {
+			return nil, err
+		}
+		defer C.duckdb_destroy_logical_type(&entryType)
+		return C.duckdb_create_list_type(entryType), nil
+	default:
+		return nil, unsupportedTypeError(t.String())
+	}
+	return C.duckdb_create_logical_type(duckdbType), nil
+}
@@ -7,6 +7,7 @@ import "C"
 
 import (
 	"reflect"
+	"time"
 	"unsafe"
 )
 
@@ -40,6 +41,20 @@ func (*vector) canNil(val reflect.Value) bool {
 }
 
 func (vec *vector) init(logicalType C.duckdb_logical_type, colIdx int) error {
+	if err := vec.initType(logicalType, colIdx); err != nil {
+		return err
+	}
+
+	// Set NULL for typed nil values, e.g., a nil *int32, and set the value of other pointers,
+	// including for the values of nested types.
+	setFn := vec.setFn
+	vec.setFn = func(vec *vector, rowIdx C.idx_t, val any) error {
+		return setFn(vec, rowIdx, derefNil(val))
+	}
+	return nil
+}
+
+func (vec *vector) initType(logicalType C.duckdb_logical_type, colIdx int) error {
 	t := Type(C.duckdb_get_type_id(logicalType))
 	name, inMap := unsupportedTypeToStringMap[t]
 	if inMap {
@@ -75,10 +90,14 @@ func (vec *vector) init(logicalType C.duckdb_logical_type, colIdx int) error {
 		vec.initDate()
 	case TYPE_TIME:
 		vec.initTime()
+	case TYPE_TIME_TZ:
+		vec.initTimeTZ()
 	case TYPE_INTERVAL:
 		vec.initInterval()
 	case TYPE_HUGEINT:
 		vec.initHugeint()
+	case TYPE_UHUGEINT:
+		vec.initUhugeint()
 	case TYPE_VARCHAR, TYPE_BLOB:
 		vec.initBytes(t)
 	case TYPE_DECIMAL:
@@ -87,12 +106,18 @@ func (vec *vector) init(logicalType C.duckdb_logical_type, colIdx int) error {
 		return vec.initEnum(logicalType, colIdx)
 	case TYPE_LIST:
 		return vec.initList(logicalType, colIdx)
+	case TYPE_ARRAY:
+		return vec.initArray(logicalType, colIdx)
 	case TYPE_STRUCT:
 		return vec.initStruct(logicalType, colIdx)
 	case TYPE_MAP:
 		return vec.initMap(logicalType, colIdx)
+	case TYPE_UNION:
+		return vec.initUnion(logicalType, colIdx)
 	case TYPE_UUID:
 		vec.initUUID()
+	case TYPE_BIT:
+		vec.initBit()
 	case TYPE_SQLNULL:
 		vec.initSQLNull()
 	default:
@@ -129,7 +154,10 @@ func (vec *vector) getChildVectors(v C.duckdb_vector, writable bool) {
 	case TYPE_LIST, TYPE_MAP:
 		child := C.duckdb_list_vector_get_child(v)
 		vec.childVectors[0].initVectors(child, writable)
-	case TYPE_STRUCT:
+	case TYPE_ARRAY:
+		child := C.duckdb_array_vector_get_child(v)
+		vec.childVectors[0].initVectors(child, writable)
+	case TYPE_STRUCT, TYPE_UNION:
 		for i := 0; i < len(vec.childVectors); i++ {
 			child := C.duckdb_struct_vector_get_child(v, C.idx_t(i))
 			vec.childVectors[i].initVectors(child, writable)
@@ -222,6 +250,23 @@ func (vec *vector) initTime() {
 	vec.Type = TYPE_TIME
 }
 
+func (vec *vector) initTimeTZ() {
+	vec.getFn = func(vec *vector, rowIdx C.idx_t) any {
+		if vec.getNull(rowIdx) {
+			return nil
+		}
+		return vec.getTimeTZ(rowIdx)
+	}
+	vec.setFn = func(vec *vector, rowIdx C.idx_t, val any) error {
+		if val == nil {
+			vec.setNull(rowIdx)
+			return nil
+		}
+		return setTimeTZ(vec, rowIdx, val)
+	}
+	vec.Type = TYPE_TIME_TZ
+}
+
 func (vec *vector) initInterval() {
 	vec.getFn = func(vec *vector, rowIdx C.idx_t) any {
 		if vec.getNull(rowIdx) {
@@ -256,6 +301,23 @@ func (vec *vector) initHugeint() {
 	vec.Type = TYPE_HUGEINT
 }
 
+func (vec *vector) initUhugeint() {
+	vec.getFn = func(vec *vector, rowIdx C.idx_t) any {
+		if vec.getNull(rowIdx) {
+			return nil
+		}
+		return vec.getUhugeint(rowIdx)
+	}
+	vec.setFn = func(vec *vector, rowIdx C.idx_t, val any) error {
+		if val == nil {
+			vec.setNull(rowIdx)
+			return nil
+		}
+		return setUhugeint(vec, rowIdx, val)
+	}
+	vec.Type = TYPE_UHUGEINT
+}
+
 func (vec *vector) initBytes(t Type) {
 	vec.getFn = func(vec *vector, rowIdx C.idx_t) any {
 		if vec.getNull(rowIdx) {
@@ -305,11 +367,13 @@ func (vec *vector) initDecimal(logicalType C.duckdb_logical_type, colIdx int) er
 func (vec *vector) initEnum(logicalType C.duckdb_logical_type, colIdx int) error {
 	// Initialize the dictionary.
 	dictSize := uint32(C.duckdb_enum_dictionary_size(logicalType))
-	vec.dict = make(map[string]uint32)
+	vec.dict = make(map[string]uint32, dictSize)
+	vec.names = make([]string, dictSize)
 	for i := uint32(0); i < dictSize; i++ {
 		cStr := C.duckdb_enum_dictionary_value(logicalType, C.idx_t(i))
 		str := C.GoString(cStr)
 		vec.dict[str] = i
+		vec.names[i] = str
 		C.duckdb_free(unsafe.Pointer(cStr))
 	}
 
@@ -338,6 +402,99 @@ func (vec *vector) initEnum(logicalType C.duckdb_logical_type, colIdx int) error
 	return nil
 }
 
+// forEach calls f for the vector and all of its nested child vectors.
+func (vec *vector) forEach(f func(vec *vector)) {
+	f(vec)
+	for i := range vec.childVectors {
+		vec.childVectors[i].forEach(f)
+	}
+}
+
+// useEnumCodes makes all ENUM vectors, including nested ones, return their underlying integer code.
+func (vec *vector) useEnumCodes() {
+	vec.forEach(func(vec *vector) {
+		if vec.Type != TYPE_ENUM {
+			return
+		}
+		vec.getFn = func(vec *vector, rowIdx C.idx_t) any {
+			if vec.getNull(rowIdx) {
+				return nil
+			}
+			return vec.getEnumCode(rowIdx)
+		}
+	})
+}
+
+// useDateType makes all DATE vectors, including nested ones, return a Date instead of a time.Time.
+func (vec *vector) useDateType() {
+	vec.forEach(func(vec *vector) {
+		if vec.Type != TYPE_DATE {
+			return
+		}
+		vec.getFn = func(vec *vector, rowIdx C.idx_t) any {
+			if vec.getNull(rowIdx) {
+				return nil
+			}
+			return NewDate(vec.getDate(rowIdx))
+		}
+	})
+}
+
+// useLocation makes all TIMESTAMP_TZ vectors, including nested ones, return their values in loc.
+func (vec *vector) useLocation(loc *time.Location) {
+	vec.forEach(func(vec *vector) {
+		if vec.Type != TYPE_TIMESTAMP_TZ {
+			return
+		}
+		vec.getFn = func(vec *vector, rowIdx C.idx_t) any {
+			if vec.getNull(rowIdx) {
+				return nil
+			}
+			return vec.getTS(TYPE_TIMESTAMP_TZ, rowIdx).In(loc)
+		}
+	})
+}
+
+// useTimestampMicros makes all TIMESTAMP and TIMESTAMP_TZ vectors, including nested ones,
+// return their int64 microseconds instead of a time.Time.
+func (vec *vector) useTimestampMicros() {
+	vec.forEach(func(vec *vector) {
+		if vec.Type != TYPE_TIMESTAMP && vec.Type != TYPE_TIMESTAMP_TZ {
+			return
+		}
+		vec.getFn = func(vec *vector, rowIdx C.idx_t) any {
+			if vec.getNull(rowIdx) {
+				return nil
+			}
+			return int64(getPrimitive[C.duckdb_timestamp](vec, rowIdx).micros)
+		}
+	})
+}
+
+// useOrderedMaps makes all MAP vectors, including nested ones, return an OrderedMap instead of a Map.
+func (vec *vector) useOrderedMaps() {
+	vec.forEach(func(vec *vector) {
+		if vec.Type != TYPE_MAP {
+			return
+		}
+		vec.getFn = func(vec *vector, rowIdx C.idx_t) any {
+			if vec.getNull(rowIdx) {
+				return nil
+			}
+			return vec.getOrderedMap(rowIdx)
+		}
+	})
+}
+
+// containsType returns true, if the vector or any of its nested child vectors has type t.
+func (vec *vector) containsType(t Type) bool {
+	found := false
+	vec.forEach(func(vec *vector) {
+		found = found || vec.Type == t
+	})
+	return found
+}
+
 func (vec *vector) initList(logicalType C.duckdb_logical_type, colIdx int) error {
 	// Get the child vector type.
 	childType := C.duckdb_list_type_child_type(logicalType)
@@ -367,6 +524,36 @@ func (vec *vector) initList(logicalType C.duckdb_logical_type, colIdx int) error
 	return nil
 }
 
+func (vec *vector) initArray(logicalType C.duckdb_logical_type, colIdx int) error {
+	// Get the child vector type.
+	childType := C.duckdb_array_type_child_type(logicalType)
+	defer C.duckdb_destroy_logical_type(&childType)
+
+	// Recurse into the child. The child vector holds the elements of row i at [i*arraySize, (i+1)*arraySize).
+	vec.childVectors = make([]vector, 1)
+	err := vec.childVectors[0].init(childType, colIdx)
+	if err != nil {
+		return err
+	}
+	vec.arraySize = int(C.duckdb_array_type_array_size(logicalType))
+
+	vec.getFn = func(vec *vector, rowIdx C.idx_t) any {
+		if vec.getNull(rowIdx) {
+			return nil
+		}
+		return vec.getArray(rowIdx)
+	}
+	vec.setFn = func(vec *vector, rowIdx C.idx_t, val any) error {
+		if val == nil {
+			vec.setNull(rowIdx)
+			return nil
+		}
+		return setArray(vec, rowIdx, val)
+	}
+	vec.Type = TYPE_ARRAY
+	return nil
+}
+
 func (vec *vector) initStruct(logicalType C.duckdb_logical_type, colIdx int) error {
 	childCount := int(C.duckdb_struct_type_child_count(logicalType))
 	var structEntries []StructEntry
@@ -433,7 +620,7 @@ func (vec *vector) initMap(logicalType C.duckdb_logical_type, colIdx int) error
 	t := Type(C.duckdb_get_type_id(keyType))
 	switch t {
 	case TYPE_LIST, TYPE_STRUCT, TYPE_MAP, TYPE_ARRAY:
-		return addIndexToError(errUnsupportedMapKeyType, colIdx)
+		return addIndexToError(unsupportedMapKeyTypeError(logicalTypeName(keyType)), colIdx)
 	}
 
 	vec.getFn = func(vec *vector, rowIdx C.idx_t) any {
@@ -453,6 +640,48 @@ func (vec *vector) initMap(logicalType C.duckdb_logical_type, colIdx int) error
 	return nil
 }
 
+func (vec *vector) initUnion(logicalType C.duckdb_logical_type, colIdx int) error {
+	// A UNION is a STRUCT. Its first child holds the UTINYINT tag of the active member,
+	// and the remaining children hold the members.
+	memberCount := int(C.duckdb_union_type_member_count(logicalType))
+	vec.childVectors = make([]vector, memberCount+1)
+	initNumeric[uint8](&vec.childVectors[0], TYPE_UTINYINT)
+
+	for i := 0; i < memberCount; i++ {
+		name := C.duckdb_union_type_member_name(logicalType, C.idx_t(i))
+		entry, err := NewStructEntry(nil, C.GoString(name))
+		vec.structEntries = append(vec.structEntries, entry)
+		C.duckdb_free(unsafe.Pointer(name))
+		if err != nil {
+			return err
+		}
+
+		// Recurse into the member.
+		memberType := C.duckdb_union_type_member_type(logicalType, C.idx_t(i))
+		err = vec.childVectors[i+1].init(memberType, colIdx)
+		C.duckdb_destroy_logical_type(&memberType)
+		if err != nil {
+			return err
+		}
+	}
+
+	vec.getFn = func(vec *vector, rowIdx C.idx_t) any {
+		if vec.getNull(rowIdx) {
+			return nil
+		}
+		return vec.getUnion(rowIdx)
+	}
+	vec.setFn = func(vec *vector, rowIdx C.idx_t, val any) error {
+		if val == nil {
+			vec.setNull(rowIdx)
+			return nil
+		}
+		return setUnion(vec, rowIdx, val)
+	}
+	vec.Type = TYPE_UNION
+	return nil
+}
+
 func (vec *vector) initUUID() {
 	vec.getFn = func(vec *vector, rowIdx C.idx_t) any {
 		if vec.getNull(rowIdx) {
@@ -471,6 +700,23 @@ func (vec *vector) initUUID() {
 	vec.Type = TYPE_UUID
 }
 
+func (vec *vector) initBit() {
+	vec.getFn = func(vec *vector, rowIdx C.idx_t) any {
+		if vec.getNull(rowIdx) {
+			return nil
+		}
+		return bitstringFromDuckDB(vec.getCString(rowIdx).([]byte))
+	}
+	vec.setFn = func(vec *vector, rowIdx C.idx_t, val any) error {
+		if val == nil {
+			vec.setNull(rowIdx)
+			return nil
+		}
+		return setBit(vec, rowIdx, val)
+	}
+	vec.Type = TYPE_BIT
+}
+
 func (vec *vector) initSQLNull() {
 	vec.getFn = func(vec *vector, rowIdx C.idx_t) any {
 		return nil
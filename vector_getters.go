@@ -59,12 +59,18 @@ func (vec *vector) getDate(rowIdx C.idx_t) time.Time {
 	return time.Date(int(date.year), time.Month(date.month), int(date.day), 0, 0, 0, 0, time.UTC)
 }
 
+// getTime returns the time of day on January 1, 1970 UTC.
 func (vec *vector) getTime(rowIdx C.idx_t) time.Time {
 	val := getPrimitive[C.duckdb_time](vec, rowIdx)
 	micros := val.micros
 	return time.UnixMicro(int64(micros)).UTC()
 }
 
+func (vec *vector) getTimeTZ(rowIdx C.idx_t) TimeTZ {
+	val := getPrimitive[C.duckdb_time_tz](vec, rowIdx)
+	return timeTZFromDuckDB(val)
+}
+
 func (vec *vector) getInterval(rowIdx C.idx_t) Interval {
 	val := getPrimitive[C.duckdb_interval](vec, rowIdx)
 	interval := Interval{
@@ -80,22 +86,26 @@ func (vec *vector) getHugeint(rowIdx C.idx_t) *big.Int {
 	return hugeIntToNative(hugeInt)
 }
 
+func (vec *vector) getUhugeint(rowIdx C.idx_t) *big.Int {
+	uhugeInt := getPrimitive[C.duckdb_uhugeint](vec, rowIdx)
+	return uhugeIntToNative(uhugeInt)
+}
+
 func (vec *vector) getCString(rowIdx C.idx_t) any {
 	cStr := getPrimitive[duckdb_string_t](vec, rowIdx)
 
-	var blob []byte
-	if cStr.length <= stringInlineLength {
-		// Inlined data is stored from byte 4 to stringInlineLength + 4.
-		blob = C.GoBytes(unsafe.Pointer(&cStr.prefix), C.int(cStr.length))
-	} else {
-		// Any strings exceeding stringInlineLength are stored as a pointer in `ptr`.
-		blob = C.GoBytes(unsafe.Pointer(cStr.ptr), C.int(cStr.length))
+	// Inlined data is stored from byte 4 to stringInlineLength + 4.
+	// Any strings exceeding stringInlineLength are stored as a pointer in `ptr`.
+	ptr := unsafe.Pointer(&cStr.prefix)
+	if cStr.length > stringInlineLength {
+		ptr = unsafe.Pointer(cStr.ptr)
 	}
 
+	// Copy the data exactly once.
 	if vec.Type == TYPE_VARCHAR {
-		return string(blob)
+		return C.GoStringN((*C.char)(ptr), C.int(cStr.length))
 	}
-	return blob
+	return C.GoBytes(ptr, C.int(cStr.length))
 }
 
 func (vec *vector) getDecimal(rowIdx C.idx_t) Decimal {
@@ -133,13 +143,20 @@ func (vec *vector) getEnum(rowIdx C.idx_t) string {
 	case TYPE_UBIGINT:
 		idx = getPrimitive[uint64](vec, rowIdx)
 	}
+	return vec.names[idx]
+}
 
-	logicalType := C.duckdb_vector_get_column_type(vec.duckdbVector)
-	defer C.duckdb_destroy_logical_type(&logicalType)
-
-	val := C.duckdb_enum_dictionary_value(logicalType, (C.idx_t)(idx))
-	defer C.duckdb_free(unsafe.Pointer(val))
-	return C.GoString(val)
+func (vec *vector) getEnumCode(rowIdx C.idx_t) any {
+	switch vec.internalType {
+	case TYPE_UTINYINT:
+		return getPrimitive[uint8](vec, rowIdx)
+	case TYPE_USMALLINT:
+		return getPrimitive[uint16](vec, rowIdx)
+	case TYPE_UINTEGER:
+		return getPrimitive[uint32](vec, rowIdx)
+	default:
+		return getPrimitive[uint64](vec, rowIdx)
+	}
 }
 
 func (vec *vector) getList(rowIdx C.idx_t) []any {
@@ -155,6 +172,16 @@ func (vec *vector) getList(rowIdx C.idx_t) []any {
 	return slice
 }
 
+func (vec *vector) getArray(rowIdx C.idx_t) []any {
+	slice := make([]any, vec.arraySize)
+	child := &vec.childVectors[0]
+	offset := rowIdx * C.idx_t(vec.arraySize)
+	for i := range slice {
+		slice[i] = child.getFn(child, offset+C.idx_t(i))
+	}
+	return slice
+}
+
 func (vec *vector) getStruct(rowIdx C.idx_t) map[string]any {
 	m := map[string]any{}
 	for i := 0; i < len(vec.childVectors); i++ {
@@ -165,6 +192,15 @@ func (vec *vector) getStruct(rowIdx C.idx_t) map[string]any {
 	return m
 }
 
+func (vec *vector) getUnion(rowIdx C.idx_t) Union {
+	tag := getPrimitive[uint8](&vec.childVectors[0], rowIdx)
+	member := &vec.childVectors[tag+1]
+	return Union{
+		Tag:   vec.structEntries[tag].Name(),
+		Value: member.getFn(member, rowIdx),
+	}
+}
+
 func (vec *vector) getMap(rowIdx C.idx_t) Map {
 	list := vec.getList(rowIdx)
 
@@ -177,3 +213,14 @@ func (vec *vector) getMap(rowIdx C.idx_t) Map {
 	}
 	return m
 }
+
+func (vec *vector) getOrderedMap(rowIdx C.idx_t) OrderedMap {
+	list := vec.getList(rowIdx)
+
+	m := make(OrderedMap, len(list))
+	for i := 0; i < len(list); i++ {
+		mapItem := list[i].(map[string]any)
+		m[i] = MapEntry{Key: mapItem[mapKeysField()], Value: mapItem[mapValuesField()]}
+	}
+	return m
+}
@@ -6,6 +6,7 @@ package duckdb
 import "C"
 
 import (
+	"fmt"
 	"math/big"
 	"reflect"
 	"time"
@@ -21,11 +22,27 @@ type fnSetVectorValue func(vec *vector, rowIdx C.idx_t, val any) error
 func (vec *vector) setNull(rowIdx C.idx_t) {
 	C.duckdb_validity_set_row_invalid(vec.mask, rowIdx)
 
-	if vec.Type == TYPE_STRUCT {
+	if vec.Type == TYPE_STRUCT || vec.Type == TYPE_UNION {
 		for i := 0; i < len(vec.childVectors); i++ {
 			vec.childVectors[i].setNull(rowIdx)
 		}
 	}
+	if vec.Type == TYPE_ARRAY {
+		offset := rowIdx * C.idx_t(vec.arraySize)
+		for i := 0; i < vec.arraySize; i++ {
+			vec.childVectors[0].setNull(offset + C.idx_t(i))
+		}
+	}
+}
+
+func (vec *vector) setValid(rowIdx C.idx_t) {
+	C.duckdb_validity_set_row_valid(vec.mask, rowIdx)
+
+	if vec.Type == TYPE_STRUCT {
+		for i := 0; i < len(vec.childVectors); i++ {
+			vec.childVectors[i].setValid(rowIdx)
+		}
+	}
 }
 
 func setPrimitive[T any](vec *vector, rowIdx C.idx_t, v T) {
@@ -93,6 +110,9 @@ func setTS[S any](vec *vector, t Type, rowIdx C.idx_t, val S) error {
 	switch v := any(val).(type) {
 	case time.Time:
 		ti = v
+	case TimestampMicros:
+		setPrimitive(vec, rowIdx, C.duckdb_timestamp{micros: C.int64_t(timestampMicrosTicks(int64(v), t))})
+		return nil
 	default:
 		return castError(reflect.TypeOf(val).String(), reflect.TypeOf(t).String())
 	}
@@ -115,6 +135,27 @@ func setTS[S any](vec *vector, t Type, rowIdx C.idx_t, val S) error {
 	return nil
 }
 
+// timestampMicrosTicks converts microseconds to the ticks of the timestamp type t.
+// Like a time.Time, it truncates the microseconds to seconds or milliseconds towards the past.
+func timestampMicrosTicks(micros int64, t Type) int64 {
+	floorDiv := func(a, b int64) int64 {
+		q := a / b
+		if a%b < 0 {
+			q--
+		}
+		return q
+	}
+	switch t {
+	case TYPE_TIMESTAMP_S:
+		return floorDiv(micros, 1000000)
+	case TYPE_TIMESTAMP_MS:
+		return floorDiv(micros, 1000)
+	case TYPE_TIMESTAMP_NS:
+		return micros * 1000
+	}
+	return micros
+}
+
 func setDate[S any](vec *vector, rowIdx C.idx_t, val S) error {
 	var date time.Time
 	switch v := any(val).(type) {
@@ -131,21 +172,46 @@ func setDate[S any](vec *vector, rowIdx C.idx_t, val S) error {
 }
 
 func setTime[S any](vec *vector, rowIdx C.idx_t, val S) error {
-	var date time.Time
+	var micros int64
 	switch v := any(val).(type) {
 	case time.Time:
-		date = v
+		// We take the clock of the time in its location.
+		hour, minute, sec := v.Clock()
+		micros = (int64(hour)*3600+int64(minute)*60+int64(sec))*1e6 + int64(v.Nanosecond())/1e3
+	case time.Duration:
+		// A duration is the time since midnight.
+		if v < 0 || v >= 24*time.Hour {
+			return outOfRangeError(fmt.Sprintf("time.Duration(%s) is out of range for TIME", v))
+		}
+		micros = v.Microseconds()
 	default:
-		return castError(reflect.TypeOf(val).String(), reflect.TypeOf(date).String())
+		return castError(reflect.TypeOf(val).String(), reflect.TypeOf(time.Time{}).String())
 	}
-	ticks := date.UTC().UnixMicro()
 
 	var t C.duckdb_time
-	t.micros = C.int64_t(ticks)
+	t.micros = C.int64_t(micros)
 	setPrimitive(vec, rowIdx, t)
 	return nil
 }
 
+func setTimeTZ[S any](vec *vector, rowIdx C.idx_t, val S) error {
+	var t TimeTZ
+	switch v := any(val).(type) {
+	case TimeTZ:
+		t = v
+	case time.Time:
+		t = NewTimeTZ(v)
+	default:
+		return castError(reflect.TypeOf(val).String(), reflect.TypeOf(t).String())
+	}
+	tz, err := timeTZToDuckDB(t)
+	if err != nil {
+		return err
+	}
+	setPrimitive(vec, rowIdx, tz)
+	return nil
+}
+
 func setInterval[S any](vec *vector, rowIdx C.idx_t, val S) error {
 	var interval Interval
 	switch v := any(val).(type) {
@@ -169,15 +235,21 @@ func setHugeint[S any](vec *vector, rowIdx C.idx_t, val S) error {
 	case uint8:
 		fv = C.duckdb_hugeint{lower: C.uint64_t(v)}
 	case int8:
-		fv = C.duckdb_hugeint{lower: C.uint64_t(v)}
+		if fv, err = hugeIntFromNative(big.NewInt(int64(v))); err != nil {
+			return err
+		}
 	case uint16:
 		fv = C.duckdb_hugeint{lower: C.uint64_t(v)}
 	case int16:
-		fv = C.duckdb_hugeint{lower: C.uint64_t(v)}
+		if fv, err = hugeIntFromNative(big.NewInt(int64(v))); err != nil {
+			return err
+		}
 	case uint32:
 		fv = C.duckdb_hugeint{lower: C.uint64_t(v)}
 	case int32:
-		fv = C.duckdb_hugeint{lower: C.uint64_t(v)}
+		if fv, err = hugeIntFromNative(big.NewInt(int64(v))); err != nil {
+			return err
+		}
 	case uint64:
 		fv = C.duckdb_hugeint{lower: C.uint64_t(v)}
 	case int64:
@@ -219,27 +291,106 @@ func setHugeint[S any](vec *vector, rowIdx C.idx_t, val S) error {
 	return nil
 }
 
+func setUhugeint[S any](vec *vector, rowIdx C.idx_t, val S) error {
+	var i *big.Int
+	switch v := any(val).(type) {
+	case uint8:
+		i = new(big.Int).SetUint64(uint64(v))
+	case int8:
+		i = big.NewInt(int64(v))
+	case uint16:
+		i = new(big.Int).SetUint64(uint64(v))
+	case int16:
+		i = big.NewInt(int64(v))
+	case uint32:
+		i = new(big.Int).SetUint64(uint64(v))
+	case int32:
+		i = big.NewInt(int64(v))
+	case uint64:
+		i = new(big.Int).SetUint64(v)
+	case int64:
+		i = big.NewInt(v)
+	case uint:
+		i = new(big.Int).SetUint64(uint64(v))
+	case int:
+		i = big.NewInt(int64(v))
+	case *big.Int:
+		if v == nil {
+			return castError(reflect.TypeOf(val).String(), reflect.TypeOf(i).String())
+		}
+		i = v
+	default:
+		return castError(reflect.TypeOf(val).String(), reflect.TypeOf(i).String())
+	}
+
+	fv, err := uhugeIntFromNative(i)
+	if err != nil {
+		return err
+	}
+	setPrimitive(vec, rowIdx, fv)
+	return nil
+}
+
 func setBytes[S any](vec *vector, rowIdx C.idx_t, val S) error {
+	// DuckDB copies the data into the vector, so we can pass Go memory without copying it to C memory first.
 	var cStr *C.char
 	var length int
 	switch v := any(val).(type) {
 	case string:
-		cStr = C.CString(v)
-		defer C.duckdb_free(unsafe.Pointer(cStr))
+		cStr = (*C.char)(unsafe.Pointer(unsafe.StringData(v)))
 		length = len(v)
 	case []byte:
-		cStr = (*C.char)(C.CBytes(v))
-		defer C.duckdb_free(unsafe.Pointer(cStr))
+		cStr = (*C.char)(unsafe.Pointer(unsafe.SliceData(v)))
 		length = len(v)
 	default:
-		return castError(reflect.TypeOf(val).String(), reflect.TypeOf(cStr).String())
+		return castError(reflect.TypeOf(val).String(), reflect.TypeOf("").String())
 	}
 
 	C.duckdb_vector_assign_string_element_len(vec.duckdbVector, rowIdx, cStr, C.idx_t(length))
 	return nil
 }
 
+func setBit[S any](vec *vector, rowIdx C.idx_t, val S) error {
+	var b Bitstring
+	switch v := any(val).(type) {
+	case Bitstring:
+		b = v
+	case string:
+		var err error
+		if b, err = NewBitstring(v); err != nil {
+			return err
+		}
+	default:
+		return castError(reflect.TypeOf(val).String(), reflect.TypeOf(b).String())
+	}
+
+	data, err := b.toDuckDB()
+	if err != nil {
+		return err
+	}
+	return setBytes(vec, rowIdx, data)
+}
+
 func setDecimal[S any](vec *vector, rowIdx C.idx_t, val S) error {
+	// Rescale Decimal values to the scale of the column.
+	if d, ok := any(val).(Decimal); ok {
+		v, err := decimalValue(d, vec.decimalWidth, vec.decimalScale)
+		if err != nil {
+			return err
+		}
+		switch vec.internalType {
+		case TYPE_SMALLINT:
+			setPrimitive(vec, rowIdx, int16(v.Int64()))
+		case TYPE_INTEGER:
+			setPrimitive(vec, rowIdx, int32(v.Int64()))
+		case TYPE_BIGINT:
+			setPrimitive(vec, rowIdx, v.Int64())
+		case TYPE_HUGEINT:
+			return setHugeint(vec, rowIdx, v)
+		}
+		return nil
+	}
+
 	switch vec.internalType {
 	case TYPE_SMALLINT:
 		return setNumeric[S, int16](vec, rowIdx, val)
@@ -294,13 +445,7 @@ func setList[S any](vec *vector, rowIdx C.idx_t, val S) error {
 		list = make([]any, rv.Len())
 
 		for i := 0; i < rv.Len(); i++ {
-			idx := rv.Index(i)
-			if vec.canNil(idx) && idx.IsNil() {
-				list[i] = nil
-				continue
-			}
-
-			list[i] = idx.Interface()
+			list[i] = derefValue(vec, rv.Index(i))
 		}
 	}
 	childVectorSize := C.duckdb_list_vector_get_size(vec.duckdbVector)
@@ -321,7 +466,79 @@ func setList[S any](vec *vector, rowIdx C.idx_t, val S) error {
 		offset := C.idx_t(i) + childVectorSize
 		err := childVector.setFn(childVector, offset, entry)
 		if err != nil {
-			return err
+			return addIndexToError(err, i)
+		}
+	}
+	return nil
+}
+
+// derefNil returns nil for nil pointers, slices, and maps, and the value of other pointers,
+// except for *big.Int, which the HUGEINT setters expect.
+func derefNil(val any) any {
+	if val == nil {
+		return nil
+	}
+	rv := reflect.ValueOf(val)
+	switch rv.Kind() {
+	case reflect.Slice, reflect.Map:
+		if rv.IsNil() {
+			return nil
+		}
+		return val
+	case reflect.Pointer:
+		if rv.IsNil() {
+			return nil
+		}
+		if _, ok := val.(*big.Int); ok {
+			return val
+		}
+		return derefNil(rv.Elem().Interface())
+	default:
+		return val
+	}
+}
+
+// derefValue returns the value of a list element or struct field.
+// It dereferences pointers, e.g., of a []*int64 or a *string field, and returns nil for nil values.
+func derefValue(vec *vector, val reflect.Value) any {
+	if vec.canNil(val) && val.IsNil() {
+		return nil
+	}
+	// The setters expect *big.Int values instead of big.Int values.
+	if val.Kind() == reflect.Pointer && val.Type() != reflectTypeBigInt {
+		val = val.Elem()
+	}
+	return val.Interface()
+}
+
+func setArray[S any](vec *vector, rowIdx C.idx_t, val S) error {
+	var list []any
+	switch v := any(val).(type) {
+	case []any:
+		list = v
+	default:
+		kind := reflect.TypeOf(val).Kind()
+		if kind != reflect.Array && kind != reflect.Slice {
+			return castError(reflect.TypeOf(val).String(), reflect.TypeOf(list).String())
+		}
+		rv := reflect.ValueOf(val)
+		list = make([]any, rv.Len())
+		for i := 0; i < rv.Len(); i++ {
+			list[i] = derefValue(vec, rv.Index(i))
+		}
+	}
+	if len(list) != vec.arraySize {
+		return outOfRangeError(fmt.Sprintf("%d elements do not fit an ARRAY of size %d", len(list), vec.arraySize))
+	}
+
+	// Insert the values into the child vector.
+	childVector := &vec.childVectors[0]
+	offset := rowIdx * C.idx_t(vec.arraySize)
+	for i, entry := range list {
+		childVector.setValid(offset + C.idx_t(i))
+		err := childVector.setFn(childVector, offset+C.idx_t(i), entry)
+		if err != nil {
+			return addIndexToError(err, i)
 		}
 	}
 	return nil
@@ -334,62 +551,148 @@ func setStruct[S any](vec *vector, rowIdx C.idx_t, val S) error {
 		m = v
 	default:
 		// FIXME: Add support for all map types.
+		rv := reflect.ValueOf(val)
+		if rv.Kind() == reflect.Pointer {
+			if rv.IsNil() {
+				vec.setNull(rowIdx)
+				return nil
+			}
+			rv = rv.Elem()
+		}
 
 		// Catch mismatching types.
-		goType := reflect.TypeOf(val)
-		if reflect.TypeOf(val).Kind() != reflect.Struct {
-			return castError(goType.String(), reflect.Struct.String())
+		structType := rv.Type()
+		if structType.Kind() != reflect.Struct {
+			return castError(reflect.TypeOf(val).String(), reflect.Struct.String())
 		}
 
 		m = make(map[string]any)
-		rv := reflect.ValueOf(val)
-		structType := rv.Type()
-
 		for i := 0; i < structType.NumField(); i++ {
 			if !rv.Field(i).CanInterface() {
 				continue
 			}
 			fieldName := structType.Field(i).Name
 			if name, ok := structType.Field(i).Tag.Lookup("db"); ok {
+				if name == "-" {
+					continue
+				}
 				fieldName = name
 			}
 			if _, ok := m[fieldName]; ok {
 				return duplicateNameError(fieldName)
 			}
-			m[fieldName] = rv.Field(i).Interface()
+			m[fieldName] = derefValue(vec, rv.Field(i))
 		}
 	}
 
+	// Catch schema drift, i.e., fields that do not exist in the STRUCT type.
+	if len(m) > len(vec.childVectors) {
+		return unexpectedStructFieldError(vec.extraStructField(m))
+	}
+
 	for i := 0; i < len(vec.childVectors); i++ {
 		child := &vec.childVectors[i]
 		name := vec.structEntries[i].Name()
 		v, ok := m[name]
 		if !ok {
+			if extra := vec.extraStructField(m); extra != "" {
+				return unexpectedStructFieldError(extra)
+			}
 			return structFieldError("missing field", name)
 		}
 		err := child.setFn(child, rowIdx, v)
 		if err != nil {
-			return err
+			return structFieldNameError(err, name)
 		}
 	}
 	return nil
 }
 
-func setMap[S any](vec *vector, rowIdx C.idx_t, val S) error {
-	var m Map
+// extraStructField returns the alphabetically first field of m that does not exist in the STRUCT type.
+func (vec *vector) extraStructField(m map[string]any) string {
+	extra := ""
+	for name := range m {
+		found := false
+		for _, entry := range vec.structEntries {
+			if entry.Name() == name {
+				found = true
+				break
+			}
+		}
+		if !found && (extra == "" || name < extra) {
+			extra = name
+		}
+	}
+	return extra
+}
+
+func setUnion[S any](vec *vector, rowIdx C.idx_t, val S) error {
+	var u Union
 	switch v := any(val).(type) {
-	case Map:
-		m = v
+	case Union:
+		u = v
+	case *Union:
+		u = *v
 	default:
-		return castError(reflect.TypeOf(val).String(), reflect.TypeOf(m).String())
+		return castError(reflect.TypeOf(val).String(), reflect.TypeOf(u).String())
 	}
 
+	// Set the tag and the active member, and set all other members to NULL.
+	tag := -1
+	for i, entry := range vec.structEntries {
+		if entry.Name() == u.Tag {
+			tag = i
+			break
+		}
+	}
+	if tag == -1 {
+		return unionTagError(u.Tag)
+	}
+
+	vec.childVectors[0].setValid(rowIdx)
+	setPrimitive(&vec.childVectors[0], rowIdx, uint8(tag))
+	for i := 1; i < len(vec.childVectors); i++ {
+		child := &vec.childVectors[i]
+		if i != tag+1 {
+			child.setNull(rowIdx)
+			continue
+		}
+		child.setValid(rowIdx)
+		if err := child.setFn(child, rowIdx, u.Value); err != nil {
+			return structFieldNameError(err, u.Tag)
+		}
+	}
+	return nil
+}
+
+func setMap[S any](vec *vector, rowIdx C.idx_t, val S) error {
 	// Create a LIST of STRUCT values.
-	i := 0
-	list := make([]any, len(m))
-	for key, value := range m {
-		list[i] = map[string]any{mapKeysField(): key, mapValuesField(): value}
-		i++
+	var list []any
+	switch v := any(val).(type) {
+	case Map:
+		list = make([]any, 0, len(v))
+		for key, value := range v {
+			list = append(list, map[string]any{mapKeysField(): key, mapValuesField(): value})
+		}
+	case OrderedMap:
+		list = make([]any, len(v))
+		for i, entry := range v {
+			list[i] = map[string]any{mapKeysField(): entry.Key, mapValuesField(): entry.Value}
+		}
+	default:
+		// Catch any other Go map, e.g., map[string]int32.
+		rv := reflect.ValueOf(val)
+		if rv.Kind() != reflect.Map {
+			return castError(reflect.TypeOf(val).String(), reflect.TypeOf(Map{}).String())
+		}
+		list = make([]any, 0, rv.Len())
+		iter := rv.MapRange()
+		for iter.Next() {
+			list = append(list, map[string]any{
+				mapKeysField():   iter.Key().Interface(),
+				mapValuesField(): iter.Value().Interface(),
+			})
+		}
 	}
 
 	return setList(vec, rowIdx, list)
@@ -409,8 +712,23 @@ func setUUID[S any](vec *vector, rowIdx C.idx_t, val S) error {
 		for i := 0; i < uuid_length; i++ {
 			uuid[i] = v[i]
 		}
+	case string:
+		var ok bool
+		if uuid, ok = parseUUID(v); !ok {
+			return castError(reflect.TypeOf(val).String(), reflect.TypeOf(uuid).String())
+		}
 	default:
-		return castError(reflect.TypeOf(val).String(), reflect.TypeOf(uuid).String())
+		// Catch [16]byte types, e.g., uuid.UUID of github.com/google/uuid, and named byte slices.
+		// Slices are convertible to arrays of any length, so we check their length.
+		rv := reflect.ValueOf(val)
+		switch {
+		case rv.Kind() == reflect.Array && rv.Type().ConvertibleTo(reflectTypeUUID):
+			uuid = rv.Convert(reflectTypeUUID).Interface().(UUID)
+		case rv.Kind() == reflect.Slice && rv.Type().Elem() == reflectTypeBytes.Elem() && rv.Len() == uuid_length:
+			reflect.Copy(reflect.ValueOf(uuid[:]), rv)
+		default:
+			return castError(fmt.Sprintf("%T", val), reflectTypeUUID.String())
+		}
 	}
 	hi := uuidToHugeInt(uuid)
 	setPrimitive(vec, rowIdx, hi)
@@ -453,10 +771,14 @@ func setVectorVal[S any](vec *vector, rowIdx C.idx_t, val S) error {
 		return setDate[S](vec, rowIdx, val)
 	case TYPE_TIME:
 		return setTime[S](vec, rowIdx, val)
+	case TYPE_TIME_TZ:
+		return setTimeTZ[S](vec, rowIdx, val)
 	case TYPE_INTERVAL:
 		return setInterval[S](vec, rowIdx, val)
 	case TYPE_HUGEINT:
 		return setHugeint[S](vec, rowIdx, val)
+	case TYPE_UHUGEINT:
+		return setUhugeint[S](vec, rowIdx, val)
 	case TYPE_VARCHAR:
 		return setBytes[S](vec, rowIdx, val)
 	case TYPE_BLOB:
@@ -467,10 +789,16 @@ func setVectorVal[S any](vec *vector, rowIdx C.idx_t, val S) error {
 		return setEnum[S](vec, rowIdx, val)
 	case TYPE_LIST:
 		return setList[S](vec, rowIdx, val)
+	case TYPE_ARRAY:
+		return setArray[S](vec, rowIdx, val)
 	case TYPE_STRUCT:
 		return setStruct[S](vec, rowIdx, val)
+	case TYPE_UNION:
+		return setUnion[S](vec, rowIdx, val)
 	case TYPE_UUID:
 		return setUUID[S](vec, rowIdx, val)
+	case TYPE_BIT:
+		return setBit[S](vec, rowIdx, val)
 	default:
 		return unsupportedTypeError(unknownTypeErrMsg)
 	}